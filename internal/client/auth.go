@@ -0,0 +1,207 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultOAuthTokenURL is the Atlassian endpoint issuing OAuth 2.0 access tokens
+	DefaultOAuthTokenURL = "https://auth.atlassian.com/oauth/token"
+
+	// tokenExpiryMargin renews access tokens a bit before they expire, so a token does not
+	// run out while a request is in flight. Short-lived tokens use half of their lifetime.
+	tokenExpiryMargin = 60 * time.Second
+)
+
+// authenticator sets the Authorization header of requests sent to the GraphQL API.
+type authenticator interface {
+	authorize(ctx context.Context, httpClient *http.Client, req *http.Request) error
+	// secrets returns the credentials that must never show up in logs
+	secrets() []string
+}
+
+// basicAuth authenticates with the email and API token of an Atlassian account.
+type basicAuth struct {
+	email    string
+	apiToken string
+}
+
+func (a *basicAuth) authorize(_ context.Context, _ *http.Client, req *http.Request) error {
+	// Atlassian Compass GraphQL API requires Basic Authentication
+	// Format: email:api_token encoded in Base64
+	authString := fmt.Sprintf("%s:%s", a.email, a.apiToken)
+	authEncoded := base64.StdEncoding.EncodeToString([]byte(authString))
+	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", authEncoded))
+	return nil
+}
+
+func (a *basicAuth) secrets() []string {
+	authEncoded := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", a.email, a.apiToken)))
+	return []string{a.apiToken, authEncoded}
+}
+
+// bearerAuth authenticates with an access token obtained outside of the provider.
+type bearerAuth struct {
+	accessToken string
+}
+
+func (a *bearerAuth) authorize(_ context.Context, _ *http.Client, req *http.Request) error {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.accessToken))
+	return nil
+}
+
+func (a *bearerAuth) secrets() []string {
+	return []string{a.accessToken}
+}
+
+// clientCredentialsAuth authenticates with access tokens issued to an OAuth 2.0 client
+// (e.g. a service account) through the client credentials grant. The token is requested
+// on first use and renewed when it expires or is rejected by the API.
+type clientCredentialsAuth struct {
+	clientID     string
+	clientSecret string
+	tokenURL     string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (a *clientCredentialsAuth) authorize(ctx context.Context, httpClient *http.Client, req *http.Request) error {
+	token, err := a.token(ctx, httpClient)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+func (a *clientCredentialsAuth) secrets() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken == "" {
+		return []string{a.clientSecret}
+	}
+	return []string{a.clientSecret, a.accessToken}
+}
+
+// token returns the cached access token, requesting a new one when there is none or it expired.
+// Concurrent requests wait for the token requested by the first one.
+func (a *clientCredentialsAuth) token(ctx context.Context, httpClient *http.Client) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken != "" && time.Now().Before(a.expiresAt) {
+		return a.accessToken, nil
+	}
+
+	reqBody, err := json.Marshal(map[string]string{
+		"grant_type":    "client_credentials",
+		"client_id":     a.clientID,
+		"client_secret": a.clientSecret,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal token request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", a.tokenURL, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// The token response is not logged, it holds the access token
+	tflog.Debug(ctx, "Requesting OAuth access token", map[string]interface{}{
+		"oauth_token_url": a.tokenURL,
+		"oauth_client_id": a.clientID,
+	})
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request OAuth access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to request OAuth access token: status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("failed to unmarshal token response: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("token response does not contain an access token")
+	}
+
+	a.accessToken = token.AccessToken
+	expiresIn := time.Duration(token.ExpiresIn) * time.Second
+	a.expiresAt = time.Now().Add(expiresIn - min(tokenExpiryMargin, expiresIn/2))
+
+	tflog.Debug(ctx, "Received OAuth access token", map[string]interface{}{
+		"oauth_expires_in": token.ExpiresIn,
+	})
+
+	return a.accessToken, nil
+}
+
+// invalidate drops the cached access token, so the next request gets a new one.
+func (a *clientCredentialsAuth) invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.accessToken = ""
+}
+
+// WithBearerToken authenticates requests with the given OAuth 2.0 access token instead of
+// email and API token. The token is sent as is and not refreshed.
+func WithBearerToken(accessToken string) Option {
+	return func(c *Client) {
+		c.auth = &bearerAuth{accessToken: accessToken}
+	}
+}
+
+// WithOAuthClientCredentials authenticates requests with access tokens obtained through the
+// OAuth 2.0 client credentials grant, e.g. for an Atlassian service account. Tokens are
+// requested from DefaultOAuthTokenURL, or the URL passed to WithOAuthTokenURL, and refreshed
+// by the client when they expire.
+func WithOAuthClientCredentials(clientID, clientSecret string) Option {
+	return func(c *Client) {
+		c.auth = &clientCredentialsAuth{
+			clientID:     clientID,
+			clientSecret: clientSecret,
+			tokenURL:     DefaultOAuthTokenURL,
+		}
+	}
+}
+
+// WithOAuthTokenURL sets the endpoint WithOAuthClientCredentials requests access tokens from,
+// e.g. for a proxy or a test server. An empty URL keeps DefaultOAuthTokenURL.
+func WithOAuthTokenURL(tokenURL string) Option {
+	return func(c *Client) {
+		c.oauthTokenURL = tokenURL
+	}
+}
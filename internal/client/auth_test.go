@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newAuthServer returns a GraphQL server accepting only requests with one of the given
+// Authorization headers.
+func newAuthServer(t *testing.T, accepted ...string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, auth := range accepted {
+			if r.Header.Get("Authorization") == auth {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{"ok":true}}`))
+				return
+			}
+		}
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTokenServer returns an OAuth token endpoint issuing token-1, token-2 and so on, valid for
+// expiresIn seconds.
+func newTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *int32) {
+	t.Helper()
+	var issued int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req["grant_type"] != "client_credentials" || req["client_id"] != "client" || req["client_secret"] != "secret" {
+			http.Error(w, `{"error":"access_denied"}`, http.StatusUnauthorized)
+			return
+		}
+		n := atomic.AddInt32(&issued, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+	return server, &issued
+}
+
+func newOAuthTestClient(t *testing.T, baseURL, tokenURL, clientSecret string) *Client {
+	t.Helper()
+	return newTestClient(t, baseURL, WithOAuthClientCredentials("client", clientSecret), WithOAuthTokenURL(tokenURL))
+}
+
+func TestExecuteQuery_BasicAuth(t *testing.T) {
+	// test@example.com:test-token
+	server := newAuthServer(t, "Basic dGVzdEBleGFtcGxlLmNvbTp0ZXN0LXRva2Vu")
+	c := newTestClient(t, server.URL)
+
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestExecuteQuery_BearerToken(t *testing.T) {
+	server := newAuthServer(t, "Bearer static-token")
+	c := newTestClient(t, server.URL, WithBearerToken("static-token"))
+
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestExecuteQuery_OAuthClientCredentials(t *testing.T) {
+	tokenServer, issued := newTokenServer(t, 3600)
+	server := newAuthServer(t, "Bearer token-1")
+	c := newOAuthTestClient(t, server.URL, tokenServer.URL, "secret")
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if *issued != 1 {
+		t.Fatalf("expected the token to be requested once, got %d", *issued)
+	}
+}
+
+func TestExecuteQuery_OAuthShortLivedToken(t *testing.T) {
+	// A 30 second token is reused for 15 seconds instead of being treated as expired right away
+	tokenServer, issued := newTokenServer(t, 30)
+	server := newAuthServer(t, "Bearer token-1")
+	c := newOAuthTestClient(t, server.URL, tokenServer.URL, "secret")
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if *issued != 1 {
+		t.Fatalf("expected the token to be requested once, got %d", *issued)
+	}
+}
+
+func TestExecuteQuery_OAuthTokenRenewed(t *testing.T) {
+	tokenServer, issued := newTokenServer(t, 3600)
+	// token-1 is rejected, e.g. because it was revoked
+	server := newAuthServer(t, "Bearer token-2")
+	c := newOAuthTestClient(t, server.URL, tokenServer.URL, "secret")
+
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *issued != 2 {
+		t.Fatalf("expected the token to be requested twice, got %d", *issued)
+	}
+}
+
+func TestExecuteQuery_OAuthTokenExpired(t *testing.T) {
+	tokenServer, issued := newTokenServer(t, 3600)
+	server := newAuthServer(t, "Bearer token-1", "Bearer token-2")
+	c := newOAuthTestClient(t, server.URL, tokenServer.URL, "secret")
+
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c.auth.(*clientCredentialsAuth).expiresAt = time.Now().Add(-time.Second)
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *issued != 2 {
+		t.Fatalf("expected the expired token to be renewed, got %d tokens", *issued)
+	}
+}
+
+func TestExecuteQuery_OAuthInvalidCredentials(t *testing.T) {
+	tokenServer, _ := newTokenServer(t, 3600)
+	server := newAuthServer(t)
+	c := newOAuthTestClient(t, server.URL, tokenServer.URL, "wrong")
+
+	_, err := c.ExecuteQuery(context.Background(), "query { ok }", nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to request OAuth access token: status 401") {
+		t.Fatalf("expected token error, got %v", err)
+	}
+}
+
+func TestNewClient_AuthValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		apiToken string
+		opts     []Option
+		wantErr  string
+	}{
+		{name: "basic", email: "test@example.com", apiToken: "test-token"},
+		{name: "basic without email", apiToken: "test-token", wantErr: "email cannot be empty"},
+		{name: "basic without token", email: "test@example.com", wantErr: "apiToken cannot be empty"},
+		{name: "bearer", opts: []Option{WithBearerToken("token")}},
+		{name: "bearer without token", opts: []Option{WithBearerToken("")}, wantErr: "accessToken cannot be empty"},
+		{name: "oauth", opts: []Option{WithOAuthClientCredentials("client", "secret")}},
+		{name: "oauth without client id", opts: []Option{WithOAuthClientCredentials("", "secret")}, wantErr: "clientID cannot be empty"},
+		{name: "oauth without client secret", opts: []Option{WithOAuthClientCredentials("client", "")}, wantErr: "clientSecret cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient("https://example.com", tt.email, tt.apiToken, tt.opts...)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
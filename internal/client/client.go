@@ -3,28 +3,44 @@ package client
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
 )
 
 const (
-	defaultTimeout = 30 * time.Second
+	// DefaultTimeout is the time limit of a single request, including reading the response
+	DefaultTimeout = 30 * time.Second
 	// GraphQL endpoint path - this provider uses ONLY GraphQL API, not REST
 	graphQLPath = "/graphql"
 )
 
 type Client struct {
 	baseURL    string
-	email      string
-	apiToken   string
 	httpClient *http.Client
+
+	// auth sets the Authorization header, basic auth with email and API token by default
+	auth authenticator
+	// oauthTokenURL overrides DefaultOAuthTokenURL for the client credentials grant
+	oauthTokenURL string
+
+	maxRetries   int
+	retryMinWait time.Duration
+	retryMaxWait time.Duration
+
+	// limiter throttles all requests of the client, it is shared by concurrent operations
+	limiter *rate.Limiter
 }
 
+// Option configures optional behaviour of the Client.
+type Option func(*Client)
+
 type GraphQLRequest struct {
 	Query     string                 `json:"query"`
 	Variables map[string]interface{} `json:"variables,omitempty"`
@@ -47,30 +63,66 @@ type Location struct {
 	Column int `json:"column"`
 }
 
-func NewClient(baseURL, email, apiToken string) (*Client, error) {
+// NewClient creates a client authenticating with email and API token. Email and API token
+// may be empty when WithBearerToken or WithOAuthClientCredentials is passed instead.
+func NewClient(baseURL, email, apiToken string, opts ...Option) (*Client, error) {
 	if baseURL == "" {
 		return nil, fmt.Errorf("baseURL cannot be empty")
 	}
-	if email == "" {
-		return nil, fmt.Errorf("email cannot be empty")
-	}
-	if apiToken == "" {
-		return nil, fmt.Errorf("apiToken cannot be empty")
-	}
 
-	return &Client{
-		baseURL:  baseURL,
-		email:    email,
-		apiToken: apiToken,
+	c := &Client{
+		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout: defaultTimeout,
+			Timeout: DefaultTimeout,
 		},
-	}, nil
+		maxRetries:   DefaultMaxRetries,
+		retryMinWait: defaultRetryMinWait,
+		retryMaxWait: DefaultRetryMaxWait,
+		limiter:      newLimiter(DefaultRequestsPerSecond),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	switch auth := c.auth.(type) {
+	case nil:
+		if email == "" {
+			return nil, fmt.Errorf("email cannot be empty")
+		}
+		if apiToken == "" {
+			return nil, fmt.Errorf("apiToken cannot be empty")
+		}
+		c.auth = &basicAuth{email: email, apiToken: apiToken}
+	case *bearerAuth:
+		if auth.accessToken == "" {
+			return nil, fmt.Errorf("accessToken cannot be empty")
+		}
+	case *clientCredentialsAuth:
+		if c.oauthTokenURL != "" {
+			auth.tokenURL = c.oauthTokenURL
+		}
+		if auth.clientID == "" {
+			return nil, fmt.Errorf("clientID cannot be empty")
+		}
+		if auth.clientSecret == "" {
+			return nil, fmt.Errorf("clientSecret cannot be empty")
+		}
+	}
+
+	return c, nil
 }
 
 // ExecuteQuery executes a GraphQL query or mutation against Atlassian Compass GraphQL API.
 // This provider uses ONLY GraphQL API, no REST endpoints are used.
+// Requests answered with 429 or 5xx are retried with backoff, mutations only on 429 and 503,
+// see WithRetry.
+// Errors are returned as *StatusError or *GraphQLErrors, see IsNotFound and friends.
+// Operations are logged through tflog, bodies only at TRACE level and without credentials.
 func (c *Client) ExecuteQuery(ctx context.Context, query string, variables map[string]interface{}) (json.RawMessage, error) {
+	ctx = c.logContext(ctx, operationName(query))
+	mutation := isMutation(query)
+
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -81,50 +133,128 @@ func (c *Client) ExecuteQuery(ctx context.Context, query string, variables map[s
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
+	loggedVariables, _ := json.Marshal(variables)
+	tflog.Debug(ctx, "Sending GraphQL request", map[string]interface{}{
+		"graphql_variables": string(loggedVariables),
+	})
+	tflog.Trace(ctx, "GraphQL request body", map[string]interface{}{
+		"http_request_body": string(jsonData),
+	})
+
+	start := time.Now()
+	var body []byte
+	tokenRenewed := false
+	for attempt := 0; ; attempt++ {
+		var statusCode int
+		var header http.Header
+		attemptStart := time.Now()
+		statusCode, header, body, err = c.doRequest(ctx, jsonData)
+		if err != nil {
+			tflog.Debug(ctx, "GraphQL request failed", map[string]interface{}{
+				"error":       err.Error(),
+				"duration_ms": time.Since(attemptStart).Milliseconds(),
+			})
+			return nil, err
+		}
+
+		tflog.Debug(ctx, "Received GraphQL response", map[string]interface{}{
+			"http_status_code": statusCode,
+			"attempt":          attempt + 1,
+			"duration_ms":      time.Since(attemptStart).Milliseconds(),
+		})
+		tflog.Trace(ctx, "GraphQL response body", map[string]interface{}{
+			"http_response_headers": redactHeaders(header),
+			"http_response_body":    string(body),
+		})
+
+		if statusCode == http.StatusOK {
+			break
+		}
+
+		// An access token may be revoked before it expires, get a new one once and try again
+		if oauth, ok := c.auth.(*clientCredentialsAuth); ok && statusCode == http.StatusUnauthorized && !tokenRenewed {
+			oauth.invalidate()
+			tokenRenewed = true
+			attempt--
+			continue
+		}
+
+		if !shouldRetry(statusCode, mutation) || attempt >= c.maxRetries {
+			return nil, &StatusError{StatusCode: statusCode, Body: string(body)}
+		}
+
+		wait := c.retryWait(attempt, header)
+		tflog.Debug(ctx, "Retrying GraphQL request", map[string]interface{}{
+			"http_status_code": statusCode,
+			"retry_wait_ms":    wait.Milliseconds(),
+		})
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, fmt.Errorf("graphQL request failed with status %d, retry aborted: %w", statusCode, err)
+		}
+	}
+
+	var graphQLResp GraphQLResponse
+	if err := json.Unmarshal(body, &graphQLResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if len(graphQLResp.Errors) > 0 {
+		errs := &GraphQLErrors{Errors: graphQLResp.Errors}
+		tflog.Debug(ctx, "GraphQL response contains errors", map[string]interface{}{
+			"graphql_errors": errs.Error(),
+			"duration_ms":    time.Since(start).Milliseconds(),
+		})
+		return nil, errs
+	}
+
+	tflog.Debug(ctx, "GraphQL request completed", map[string]interface{}{
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+
+	return graphQLResp.Data, nil
+}
+
+// doRequest sends a single POST request with the given body to the GraphQL endpoint and
+// returns the status code, headers and body of the response.
+func (c *Client) doRequest(ctx context.Context, jsonData []byte) (int, http.Header, []byte, error) {
+	// Retries are throttled as well, so they do not add to the burst that was rate limited
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to wait for rate limiter: %w", err)
+		}
+	}
+
 	// POST request to GraphQL endpoint - always uses /graphql path
 	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+graphQLPath, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Atlassian Compass GraphQL API requires Basic Authentication
-	// Format: email:api_token encoded in Base64
-	authString := fmt.Sprintf("%s:%s", c.email, c.apiToken)
-	authEncoded := base64.StdEncoding.EncodeToString([]byte(authString))
+	if err := c.auth.authorize(ctx, c.httpClient, req); err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to authorize request: %w", err)
+	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", authEncoded))
 	req.Header.Set("X-ExperimentalApi", "compass-beta")
 
+	tflog.Trace(ctx, "Sending HTTP request", map[string]interface{}{
+		"http_url":             req.URL.String(),
+		"http_request_headers": redactHeaders(req.Header),
+	})
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("graphQL request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var graphQLResp GraphQLResponse
-	if err := json.Unmarshal(body, &graphQLResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if len(graphQLResp.Errors) > 0 {
-		var errMessages []string
-		for _, err := range graphQLResp.Errors {
-			errMessages = append(errMessages, err.Message)
-		}
-		return nil, fmt.Errorf("GraphQL errors: %v", errMessages)
-	}
-
-	return graphQLResp.Data, nil
+	return resp.StatusCode, resp.Header, body, nil
 }
 
 // GetCloudIDByTenant retrieves cloud_id for a given tenant using GraphQL query.
@@ -136,43 +266,18 @@ func (c *Client) GetCloudIDByTenant(ctx context.Context, tenant string) (string,
 		hostName = hostName + ".atlassian.net"
 	}
 
-	query := `
-		query GetCloudId($hostNames: [String!]!) {
-			tenantContexts(hostNames: $hostNames) {
-				cloudId
-			}
-		}
-	`
-
-	variables := map[string]interface{}{
-		"hostNames": []string{hostName},
-	}
-
-	data, err := c.ExecuteQuery(ctx, query, variables)
+	response, err := GetTenantContexts(ctx, c, []string{hostName})
 	if err != nil {
 		return "", fmt.Errorf("failed to get cloud ID: %w", err)
 	}
 
-	type TenantContext struct {
-		CloudID string `json:"cloudId"`
-	}
-
-	type TenantContextsResponse struct {
-		TenantContexts []TenantContext `json:"tenantContexts"`
-	}
-
-	var response TenantContextsResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return "", fmt.Errorf("failed to unmarshal tenant contexts response: %w", err)
-	}
-
 	if len(response.TenantContexts) == 0 {
 		return "", fmt.Errorf("tenant '%s' not found or no access", hostName)
 	}
 
-	if response.TenantContexts[0].CloudID == "" {
+	if response.TenantContexts[0].CloudId == "" {
 		return "", fmt.Errorf("cloud ID not found for tenant '%s'", hostName)
 	}
 
-	return response.TenantContexts[0].CloudID, nil
+	return response.TenantContexts[0].CloudId, nil
 }
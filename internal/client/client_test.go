@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyServer returns a server answering the first failures requests with the given
+// status and headers and every later request with a successful GraphQL response.
+func newFlakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message":"slow down"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"ok":true}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func newTestClient(t *testing.T, baseURL string, opts ...Option) *Client {
+	t.Helper()
+	c, err := NewClient(baseURL, "test@example.com", "test-token", opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Keep the backoff short so tests run fast
+	c.retryMinWait = time.Millisecond
+	return c
+}
+
+func TestExecuteQuery_RetriesRateLimited(t *testing.T) {
+	server, calls := newFlakyServer(t, 2, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+	c := newTestClient(t, server.URL)
+
+	data, err := c.ExecuteQuery(context.Background(), "query { ok }", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"ok":true}` {
+		t.Fatalf("unexpected data: %s", data)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestExecuteQuery_RetriesServerErrors(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusBadGateway, nil)
+	c := newTestClient(t, server.URL)
+
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestExecuteQuery_MutationNotRetriedOnServerError(t *testing.T) {
+	// The write may have been committed before the gateway failed, a replay could duplicate it
+	server, calls := newFlakyServer(t, 1, http.StatusBadGateway, nil)
+	c := newTestClient(t, server.URL)
+
+	_, err := c.ExecuteQuery(context.Background(), "mutation CreateComponent { ok }", nil)
+	if err == nil || !strings.Contains(err.Error(), "status 502") {
+		t.Fatalf("expected status 502 error, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestExecuteQuery_MutationRetriedWhenNotProcessed(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		server, calls := newFlakyServer(t, 1, status, nil)
+		c := newTestClient(t, server.URL)
+
+		if _, err := c.ExecuteQuery(context.Background(), "mutation CreateComponent { ok }", nil); err != nil {
+			t.Fatalf("status %d: unexpected error: %v", status, err)
+		}
+		if *calls != 2 {
+			t.Fatalf("status %d: expected 2 calls, got %d", status, *calls)
+		}
+	}
+}
+
+func TestExecuteQuery_RetriesExhausted(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, http.StatusServiceUnavailable, nil)
+	c := newTestClient(t, server.URL, WithRetry(2, time.Millisecond))
+
+	_, err := c.ExecuteQuery(context.Background(), "query { ok }", nil)
+	if err == nil || !strings.Contains(err.Error(), "status 503") {
+		t.Fatalf("expected status 503 error, got %v", err)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestExecuteQuery_NoRetryOnClientError(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusBadRequest, nil)
+	c := newTestClient(t, server.URL)
+
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestExecuteQuery_RetryAbortedByContext(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}})
+	c := newTestClient(t, server.URL, WithRetry(3, time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.ExecuteQuery(ctx, "query { ok }", nil)
+	if err == nil || !strings.Contains(err.Error(), "retry aborted") {
+		t.Fatalf("expected aborted retry, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestServerRetryWait(t *testing.T) {
+	now := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+		ok     bool
+	}{
+		{name: "none", header: http.Header{}, ok: false},
+		{name: "retry-after seconds", header: http.Header{"Retry-After": {"5"}}, want: 5 * time.Second, ok: true},
+		{name: "retry-after date", header: http.Header{"Retry-After": {now.Add(10 * time.Second).Format(http.TimeFormat)}}, want: 10 * time.Second, ok: true},
+		{name: "rate limit reset", header: http.Header{"X-Ratelimit-Reset": {now.Add(3 * time.Second).Format(time.RFC3339)}}, want: 3 * time.Second, ok: true},
+		{name: "reset in the past", header: http.Header{"X-Ratelimit-Reset": {now.Add(-time.Minute).Format(time.RFC3339)}}, want: 0, ok: true},
+		{name: "invalid", header: http.Header{"Retry-After": {"soon"}}, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := serverRetryWait(tt.header, now)
+			if got != tt.want || ok != tt.ok {
+				t.Fatalf("expected (%v, %v), got (%v, %v)", tt.want, tt.ok, got, ok)
+			}
+		})
+	}
+}
+
+func TestRetryWait_CappedByMaxWait(t *testing.T) {
+	c := newTestClient(t, "https://example.com", WithRetry(5, 2*time.Second))
+	c.retryMinWait = time.Second
+
+	if wait := c.retryWait(0, http.Header{"Retry-After": {"120"}}); wait != 2*time.Second {
+		t.Fatalf("expected Retry-After to be capped at 2s, got %v", wait)
+	}
+	for attempt := 0; attempt < 40; attempt++ {
+		if wait := c.retryWait(attempt, http.Header{}); wait > 2*time.Second {
+			t.Fatalf("attempt %d: wait %v exceeds max wait", attempt, wait)
+		}
+	}
+}
+
+func TestExecuteQuery_RateLimit(t *testing.T) {
+	server, calls := newFlakyServer(t, 0, http.StatusOK, nil)
+	c := newTestClient(t, server.URL, WithRateLimit(20))
+
+	// The burst of 20 requests passes right away, the 5 after it wait 50ms each
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected requests to be throttled, 25 requests took %v", elapsed)
+	}
+	if *calls != 25 {
+		t.Fatalf("expected 25 calls, got %d", *calls)
+	}
+}
+
+func TestExecuteQuery_RateLimitDisabled(t *testing.T) {
+	server, _ := newFlakyServer(t, 0, http.StatusOK, nil)
+	c := newTestClient(t, server.URL, WithRateLimit(0))
+
+	if c.limiter != nil {
+		t.Fatal("expected no limiter")
+	}
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestExecuteQuery_RateLimitWaitAbortedByContext(t *testing.T) {
+	server, calls := newFlakyServer(t, 0, http.StatusOK, nil)
+	c := newTestClient(t, server.URL, WithRateLimit(0.1))
+
+	if _, err := c.ExecuteQuery(context.Background(), "query { ok }", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The next token is only available after 10s
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.ExecuteQuery(ctx, "query { ok }", nil); err == nil || !strings.Contains(err.Error(), "rate limiter") {
+		t.Fatalf("expected rate limiter error, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
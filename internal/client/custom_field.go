@@ -0,0 +1,47 @@
+package client
+
+// CustomField is a member of the CompassCustomField interface returned on components. Only the
+// value field matching TypeName is populated. The custom fields of the GetComponent query are
+// bound to it, see operations/component.graphql.
+type CustomField struct {
+	TypeName   string `json:"__typename"`
+	Definition struct {
+		ID string `json:"id"`
+	} `json:"definition"`
+	BooleanValue *bool                     `json:"booleanValue,omitempty"`
+	TextValue    *string                   `json:"textValue,omitempty"`
+	NumberValue  *float64                  `json:"numberValue,omitempty"`
+	Option       *CustomFieldOption        `json:"option,omitempty"`
+	Options      []CustomFieldOption       `json:"options,omitempty"`
+	UserValue    *CustomFieldUserReference `json:"userValue,omitempty"`
+}
+
+// CustomFieldOption references an option of a select custom field.
+type CustomFieldOption struct {
+	ID string `json:"id"`
+}
+
+// CustomFieldUserReference references the user set as value of a user custom field.
+type CustomFieldUserReference struct {
+	AccountID string `json:"accountId"`
+}
+
+// CustomFieldDefinitionResult is the result of the GetCustomFieldDefinition query: a member of the
+// CompassCustomFieldDefinition interface named by TypeName, or a QueryError when TypeName is
+// "QueryError". Options are only returned for select definitions.
+type CustomFieldDefinitionResult struct {
+	TypeName       string                        `json:"__typename"`
+	ID             string                        `json:"id"`
+	Name           string                        `json:"name"`
+	Description    string                        `json:"description"`
+	ComponentTypes []string                      `json:"componentTypes"`
+	Options        []CustomFieldDefinitionOption `json:"options,omitempty"`
+	Message        string                        `json:"message,omitempty"`
+	Extensions     []ErrorExtension              `json:"extensions,omitempty"`
+}
+
+// CustomFieldDefinitionOption is an option of a select custom field definition.
+type CustomFieldDefinitionOption struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
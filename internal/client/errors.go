@@ -0,0 +1,102 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQLErrors is returned by ExecuteQuery when the response contains GraphQL errors.
+// It keeps every error including its path and extensions.
+type GraphQLErrors struct {
+	Errors []GraphQLError
+}
+
+func (e *GraphQLErrors) Error() string {
+	var errMessages []string
+	for _, err := range e.Errors {
+		errMessages = append(errMessages, err.Message)
+	}
+	return fmt.Sprintf("GraphQL errors: %v", errMessages)
+}
+
+// ErrorExtension holds the extensions of the QueryError and MutationError objects returned
+// by Compass in place of a result.
+type ErrorExtension struct {
+	StatusCode int    `json:"statusCode"`
+	ErrorType  string `json:"errorType"`
+}
+
+// StatusError is returned by ExecuteQuery when the API responds with a status other than 200.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("graphQL request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// ErrorType returns the errorType extension of the error (e.g. NOT_FOUND), or an empty string.
+func (e GraphQLError) ErrorType() string {
+	errorType, _ := e.Extensions["errorType"].(string)
+	return errorType
+}
+
+// StatusCode returns the statusCode extension of the error, or 0 when it is not set.
+func (e GraphQLError) StatusCode() int {
+	// JSON numbers are decoded as float64
+	statusCode, _ := e.Extensions["statusCode"].(float64)
+	return int(statusCode)
+}
+
+// IsNotFound reports whether err was caused by a missing object.
+func IsNotFound(err error) bool {
+	return hasStatusOrErrorType(err, []int{http.StatusNotFound}, []string{"NOT_FOUND"})
+}
+
+// IsRateLimited reports whether err was caused by the API rate limiter.
+func IsRateLimited(err error) bool {
+	return hasStatusOrErrorType(err, []int{http.StatusTooManyRequests}, []string{"RATE_LIMIT"})
+}
+
+// IsUnauthorized reports whether err was caused by missing or insufficient credentials.
+func IsUnauthorized(err error) bool {
+	return hasStatusOrErrorType(err, []int{http.StatusUnauthorized, http.StatusForbidden}, []string{"UNAUTHENTICATED", "UNAUTHORIZED", "FORBIDDEN"})
+}
+
+// hasStatusOrErrorType checks the HTTP status of a StatusError, or the statusCode and errorType
+// extensions of any of the GraphQLErrors. errorTypes match as substrings, since Compass uses
+// specific types such as COMPONENT_NOT_FOUND.
+func hasStatusOrErrorType(err error, statusCodes []int, errorTypes []string) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		for _, statusCode := range statusCodes {
+			if statusErr.StatusCode == statusCode {
+				return true
+			}
+		}
+		return false
+	}
+
+	var graphQLErrs *GraphQLErrors
+	if !errors.As(err, &graphQLErrs) {
+		return false
+	}
+
+	for _, graphQLErr := range graphQLErrs.Errors {
+		for _, statusCode := range statusCodes {
+			if graphQLErr.StatusCode() == statusCode {
+				return true
+			}
+		}
+		errorType := strings.ToUpper(graphQLErr.ErrorType())
+		for _, t := range errorTypes {
+			if errorType != "" && strings.Contains(errorType, t) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExecuteQuery_GraphQLErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": null,
+			"errors": [{
+				"message": "Component not found",
+				"path": ["compass", "component"],
+				"extensions": {"statusCode": 404, "errorType": "COMPONENT_NOT_FOUND"}
+			}]
+		}`))
+	}))
+	defer server.Close()
+	c := newTestClient(t, server.URL)
+
+	_, err := c.ExecuteQuery(context.Background(), "query { compass { component } }", nil)
+
+	// Wrapping by the callers must keep the details accessible
+	err = fmt.Errorf("failed to read component: %w", err)
+
+	var graphQLErrs *GraphQLErrors
+	if !errors.As(err, &graphQLErrs) {
+		t.Fatalf("expected *GraphQLErrors, got %T: %v", err, err)
+	}
+	if len(graphQLErrs.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(graphQLErrs.Errors))
+	}
+	graphQLErr := graphQLErrs.Errors[0]
+	if graphQLErr.StatusCode() != 404 || graphQLErr.ErrorType() != "COMPONENT_NOT_FOUND" || len(graphQLErr.Path) != 2 {
+		t.Fatalf("unexpected error details: %+v", graphQLErr)
+	}
+	if err.Error() != "failed to read component: GraphQL errors: [Component not found]" {
+		t.Fatalf("unexpected message: %s", err.Error())
+	}
+	if !IsNotFound(err) || IsRateLimited(err) || IsUnauthorized(err) {
+		t.Fatalf("unexpected classification of %v", err)
+	}
+}
+
+func TestExecuteQuery_StatusError(t *testing.T) {
+	server, _ := newFlakyServer(t, 1, http.StatusUnauthorized, nil)
+	c := newTestClient(t, server.URL)
+
+	_, err := c.ExecuteQuery(context.Background(), "query { ok }", nil)
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected *StatusError with status 401, got %T: %v", err, err)
+	}
+	if !IsUnauthorized(err) || IsNotFound(err) {
+		t.Fatalf("unexpected classification of %v", err)
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	graphQLErr := func(extensions map[string]interface{}) error {
+		return &GraphQLErrors{Errors: []GraphQLError{{Message: "failed", Extensions: extensions}}}
+	}
+
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		rateLimited  bool
+		unauthorized bool
+	}{
+		{name: "nil", err: nil},
+		{name: "plain error", err: errors.New("boom")},
+		{name: "status 404", err: &StatusError{StatusCode: 404}, notFound: true},
+		{name: "status 429", err: &StatusError{StatusCode: 429}, rateLimited: true},
+		{name: "status 403", err: &StatusError{StatusCode: 403}, unauthorized: true},
+		{name: "status 500", err: &StatusError{StatusCode: 500}},
+		{name: "not found type", err: graphQLErr(map[string]interface{}{"errorType": "NOT_FOUND"}), notFound: true},
+		{name: "not found status", err: graphQLErr(map[string]interface{}{"statusCode": float64(404)}), notFound: true},
+		{name: "rate limited type", err: graphQLErr(map[string]interface{}{"errorType": "RATE_LIMITED"}), rateLimited: true},
+		{name: "unauthenticated type", err: graphQLErr(map[string]interface{}{"errorType": "UNAUTHENTICATED"}), unauthorized: true},
+		{name: "no extensions", err: graphQLErr(nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound = %v, want %v", got, tt.notFound)
+			}
+			if got := IsRateLimited(tt.err); got != tt.rateLimited {
+				t.Errorf("IsRateLimited = %v, want %v", got, tt.rateLimited)
+			}
+			if got := IsUnauthorized(tt.err); got != tt.unauthorized {
+				t.Errorf("IsUnauthorized = %v, want %v", got, tt.unauthorized)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package client
+
+// Typed GraphQL operations are generated from schema.graphql and operations/*.graphql,
+// see genqlient.yaml. Run `go generate ./...` after changing either of them.
+//go:generate go tool genqlient genqlient.yaml
@@ -0,0 +1,5775 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+type AddCompassComponentLabelsInput struct {
+	ComponentId string   `json:"componentId"`
+	LabelNames  []string `json:"labelNames"`
+}
+
+// GetComponentId returns AddCompassComponentLabelsInput.ComponentId, and is useful for accessing the field via an interface.
+func (v *AddCompassComponentLabelsInput) GetComponentId() string { return v.ComponentId }
+
+// GetLabelNames returns AddCompassComponentLabelsInput.LabelNames, and is useful for accessing the field via an interface.
+func (v *AddCompassComponentLabelsInput) GetLabelNames() []string { return v.LabelNames }
+
+// AddComponentLabelsCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type AddComponentLabelsCompassCompassCatalogMutationApi struct {
+	AddComponentLabels AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload `json:"addComponentLabels"`
+}
+
+// GetAddComponentLabels returns AddComponentLabelsCompassCompassCatalogMutationApi.AddComponentLabels, and is useful for accessing the field via an interface.
+func (v *AddComponentLabelsCompassCompassCatalogMutationApi) GetAddComponentLabels() AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload {
+	return v.AddComponentLabels
+}
+
+// AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload includes the requested fields of the GraphQL type AddCompassComponentLabelsPayload.
+type AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload.Success, and is useful for accessing the field via an interface.
+func (v *AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload.Errors, and is useful for accessing the field via an interface.
+func (v *AddComponentLabelsCompassCompassCatalogMutationApiAddComponentLabelsAddCompassComponentLabelsPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// AddComponentLabelsResponse is returned by AddComponentLabels on success.
+type AddComponentLabelsResponse struct {
+	Compass AddComponentLabelsCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns AddComponentLabelsResponse.Compass, and is useful for accessing the field via an interface.
+func (v *AddComponentLabelsResponse) GetCompass() AddComponentLabelsCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+type CompassComponentType string
+
+const (
+	CompassComponentTypeApplication    CompassComponentType = "APPLICATION"
+	CompassComponentTypeDatabase       CompassComponentType = "DATABASE"
+	CompassComponentTypeDocumentation  CompassComponentType = "DOCUMENTATION"
+	CompassComponentTypeInfrastructure CompassComponentType = "INFRASTRUCTURE"
+	CompassComponentTypeLibrary        CompassComponentType = "LIBRARY"
+	CompassComponentTypeService        CompassComponentType = "SERVICE"
+)
+
+type CompassComponentTypeQueryInput struct {
+	First int    `json:"first"`
+	After string `json:"after,omitempty"`
+}
+
+// GetFirst returns CompassComponentTypeQueryInput.First, and is useful for accessing the field via an interface.
+func (v *CompassComponentTypeQueryInput) GetFirst() int { return v.First }
+
+// GetAfter returns CompassComponentTypeQueryInput.After, and is useful for accessing the field via an interface.
+func (v *CompassComponentTypeQueryInput) GetAfter() string { return v.After }
+
+type CompassDeleteCustomFieldDefinitionInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CompassDeleteCustomFieldDefinitionInput.Id, and is useful for accessing the field via an interface.
+func (v *CompassDeleteCustomFieldDefinitionInput) GetId() string { return v.Id }
+
+type CompassFilterInput struct {
+	Eq string   `json:"eq,omitempty"`
+	In []string `json:"in,omitempty"`
+}
+
+// GetEq returns CompassFilterInput.Eq, and is useful for accessing the field via an interface.
+func (v *CompassFilterInput) GetEq() string { return v.Eq }
+
+// GetIn returns CompassFilterInput.In, and is useful for accessing the field via an interface.
+func (v *CompassFilterInput) GetIn() []string { return v.In }
+
+type CompassLinkType string
+
+const (
+	CompassLinkTypeChatChannel CompassLinkType = "CHAT_CHANNEL"
+	CompassLinkTypeDashboard   CompassLinkType = "DASHBOARD"
+	CompassLinkTypeDocument    CompassLinkType = "DOCUMENT"
+	CompassLinkTypeOnCall      CompassLinkType = "ON_CALL"
+	CompassLinkTypeOtherLink   CompassLinkType = "OTHER_LINK"
+	CompassLinkTypeProject     CompassLinkType = "PROJECT"
+	CompassLinkTypeRepository  CompassLinkType = "REPOSITORY"
+)
+
+type CompassRelationshipQuery struct {
+	First int    `json:"first"`
+	After string `json:"after,omitempty"`
+}
+
+// GetFirst returns CompassRelationshipQuery.First, and is useful for accessing the field via an interface.
+func (v *CompassRelationshipQuery) GetFirst() int { return v.First }
+
+// GetAfter returns CompassRelationshipQuery.After, and is useful for accessing the field via an interface.
+func (v *CompassRelationshipQuery) GetAfter() string { return v.After }
+
+type CompassRelationshipType string
+
+const (
+	CompassRelationshipTypeDependsOn CompassRelationshipType = "DEPENDS_ON"
+)
+
+type CompassScorecardImportance string
+
+const (
+	CompassScorecardImportanceRecommended CompassScorecardImportance = "RECOMMENDED"
+	CompassScorecardImportanceRequired    CompassScorecardImportance = "REQUIRED"
+	CompassScorecardImportanceUserDefined CompassScorecardImportance = "USER_DEFINED"
+)
+
+type CompassSearchComponentQuery struct {
+	Query        string                     `json:"query,omitempty"`
+	FieldFilters []CompassSearchFilterInput `json:"fieldFilters,omitempty"`
+	First        int                        `json:"first"`
+	After        string                     `json:"after,omitempty"`
+}
+
+// GetQuery returns CompassSearchComponentQuery.Query, and is useful for accessing the field via an interface.
+func (v *CompassSearchComponentQuery) GetQuery() string { return v.Query }
+
+// GetFieldFilters returns CompassSearchComponentQuery.FieldFilters, and is useful for accessing the field via an interface.
+func (v *CompassSearchComponentQuery) GetFieldFilters() []CompassSearchFilterInput {
+	return v.FieldFilters
+}
+
+// GetFirst returns CompassSearchComponentQuery.First, and is useful for accessing the field via an interface.
+func (v *CompassSearchComponentQuery) GetFirst() int { return v.First }
+
+// GetAfter returns CompassSearchComponentQuery.After, and is useful for accessing the field via an interface.
+func (v *CompassSearchComponentQuery) GetAfter() string { return v.After }
+
+type CompassSearchFilterInput struct {
+	Name   string             `json:"name"`
+	Filter CompassFilterInput `json:"filter"`
+}
+
+// GetName returns CompassSearchFilterInput.Name, and is useful for accessing the field via an interface.
+func (v *CompassSearchFilterInput) GetName() string { return v.Name }
+
+// GetFilter returns CompassSearchFilterInput.Filter, and is useful for accessing the field via an interface.
+func (v *CompassSearchFilterInput) GetFilter() CompassFilterInput { return v.Filter }
+
+// ComponentLinkFields includes the GraphQL fields of CompassLink requested by the fragment ComponentLinkFields.
+type ComponentLinkFields struct {
+	Id       string          `json:"id"`
+	Name     string          `json:"name"`
+	Type     CompassLinkType `json:"type"`
+	Url      string          `json:"url"`
+	ObjectId string          `json:"objectId"`
+}
+
+// GetId returns ComponentLinkFields.Id, and is useful for accessing the field via an interface.
+func (v *ComponentLinkFields) GetId() string { return v.Id }
+
+// GetName returns ComponentLinkFields.Name, and is useful for accessing the field via an interface.
+func (v *ComponentLinkFields) GetName() string { return v.Name }
+
+// GetType returns ComponentLinkFields.Type, and is useful for accessing the field via an interface.
+func (v *ComponentLinkFields) GetType() CompassLinkType { return v.Type }
+
+// GetUrl returns ComponentLinkFields.Url, and is useful for accessing the field via an interface.
+func (v *ComponentLinkFields) GetUrl() string { return v.Url }
+
+// GetObjectId returns ComponentLinkFields.ObjectId, and is useful for accessing the field via an interface.
+func (v *ComponentLinkFields) GetObjectId() string { return v.ObjectId }
+
+// ComponentRelationship includes the requested fields of the GraphQL type CompassRelationship.
+type ComponentRelationship struct {
+	Type      CompassRelationshipType   `json:"type"`
+	StartNode ComponentRelationshipNode `json:"-"`
+	EndNode   ComponentRelationshipNode `json:"-"`
+}
+
+// GetType returns ComponentRelationship.Type, and is useful for accessing the field via an interface.
+func (v *ComponentRelationship) GetType() CompassRelationshipType { return v.Type }
+
+// GetStartNode returns ComponentRelationship.StartNode, and is useful for accessing the field via an interface.
+func (v *ComponentRelationship) GetStartNode() ComponentRelationshipNode { return v.StartNode }
+
+// GetEndNode returns ComponentRelationship.EndNode, and is useful for accessing the field via an interface.
+func (v *ComponentRelationship) GetEndNode() ComponentRelationshipNode { return v.EndNode }
+
+func (v *ComponentRelationship) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ComponentRelationship
+		StartNode json.RawMessage `json:"startNode"`
+		EndNode   json.RawMessage `json:"endNode"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ComponentRelationship = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.StartNode
+		src := firstPass.StartNode
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalComponentRelationshipNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ComponentRelationship.StartNode: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.EndNode
+		src := firstPass.EndNode
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalComponentRelationshipNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ComponentRelationship.EndNode: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalComponentRelationship struct {
+	Type CompassRelationshipType `json:"type"`
+
+	StartNode json.RawMessage `json:"startNode"`
+
+	EndNode json.RawMessage `json:"endNode"`
+}
+
+func (v *ComponentRelationship) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ComponentRelationship) __premarshalJSON() (*__premarshalComponentRelationship, error) {
+	var retval __premarshalComponentRelationship
+
+	retval.Type = v.Type
+	{
+
+		dst := &retval.StartNode
+		src := v.StartNode
+		var err error
+		*dst, err = __marshalComponentRelationshipNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComponentRelationship.StartNode: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.EndNode
+		src := v.EndNode
+		var err error
+		*dst, err = __marshalComponentRelationshipNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComponentRelationship.EndNode: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ComponentRelationshipNode includes the requested fields of the GraphQL interface CompassRelationshipNode.
+//
+// ComponentRelationshipNode is implemented by the following types:
+// ComponentRelationshipNodeCompassComponent
+type ComponentRelationshipNode interface {
+	implementsGraphQLInterfaceComponentRelationshipNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *ComponentRelationshipNodeCompassComponent) implementsGraphQLInterfaceComponentRelationshipNode() {
+}
+
+func __unmarshalComponentRelationshipNode(b []byte, v *ComponentRelationshipNode) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassComponent":
+		*v = new(ComponentRelationshipNodeCompassComponent)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassRelationshipNode.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ComponentRelationshipNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalComponentRelationshipNode(v *ComponentRelationshipNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ComponentRelationshipNodeCompassComponent:
+		typename = "CompassComponent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ComponentRelationshipNodeCompassComponent
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ComponentRelationshipNode: "%T"`, v)
+	}
+}
+
+// ComponentRelationshipNodeCompassComponent includes the requested fields of the GraphQL type CompassComponent.
+type ComponentRelationshipNodeCompassComponent struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
+
+// GetTypename returns ComponentRelationshipNodeCompassComponent.Typename, and is useful for accessing the field via an interface.
+func (v *ComponentRelationshipNodeCompassComponent) GetTypename() string { return v.Typename }
+
+// GetId returns ComponentRelationshipNodeCompassComponent.Id, and is useful for accessing the field via an interface.
+func (v *ComponentRelationshipNodeCompassComponent) GetId() string { return v.Id }
+
+// ComponentRelationships includes the requested fields of the GraphQL interface CompassRelationshipConnectionResult.
+//
+// ComponentRelationships is implemented by the following types:
+// ComponentRelationshipsCompassRelationshipConnection
+// ComponentRelationshipsQueryError
+type ComponentRelationships interface {
+	implementsGraphQLInterfaceComponentRelationships()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *ComponentRelationshipsCompassRelationshipConnection) implementsGraphQLInterfaceComponentRelationships() {
+}
+func (v *ComponentRelationshipsQueryError) implementsGraphQLInterfaceComponentRelationships() {}
+
+func __unmarshalComponentRelationships(b []byte, v *ComponentRelationships) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassRelationshipConnection":
+		*v = new(ComponentRelationshipsCompassRelationshipConnection)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(ComponentRelationshipsQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassRelationshipConnectionResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ComponentRelationships: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalComponentRelationships(v *ComponentRelationships) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ComponentRelationshipsCompassRelationshipConnection:
+		typename = "CompassRelationshipConnection"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ComponentRelationshipsCompassRelationshipConnection
+		}{typename, v}
+		return json.Marshal(result)
+	case *ComponentRelationshipsQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ComponentRelationshipsQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ComponentRelationships: "%T"`, v)
+	}
+}
+
+// ComponentRelationshipsCompassRelationshipConnection includes the requested fields of the GraphQL type CompassRelationshipConnection.
+type ComponentRelationshipsCompassRelationshipConnection struct {
+	Typename string                  `json:"__typename"`
+	Nodes    []ComponentRelationship `json:"nodes"`
+	PageInfo PageInfo                `json:"pageInfo"`
+}
+
+// GetTypename returns ComponentRelationshipsCompassRelationshipConnection.Typename, and is useful for accessing the field via an interface.
+func (v *ComponentRelationshipsCompassRelationshipConnection) GetTypename() string { return v.Typename }
+
+// GetNodes returns ComponentRelationshipsCompassRelationshipConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ComponentRelationshipsCompassRelationshipConnection) GetNodes() []ComponentRelationship {
+	return v.Nodes
+}
+
+// GetPageInfo returns ComponentRelationshipsCompassRelationshipConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ComponentRelationshipsCompassRelationshipConnection) GetPageInfo() PageInfo {
+	return v.PageInfo
+}
+
+// ComponentRelationshipsQueryError includes the requested fields of the GraphQL type QueryError.
+type ComponentRelationshipsQueryError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns ComponentRelationshipsQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *ComponentRelationshipsQueryError) GetTypename() string { return v.Typename }
+
+// GetMessage returns ComponentRelationshipsQueryError.Message, and is useful for accessing the field via an interface.
+func (v *ComponentRelationshipsQueryError) GetMessage() string { return v.Message }
+
+// ComponentTypeFields includes the GraphQL fields of CompassComponentTypeObject requested by the fragment ComponentTypeFields.
+type ComponentTypeFields struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IconUrl     string `json:"iconUrl"`
+}
+
+// GetId returns ComponentTypeFields.Id, and is useful for accessing the field via an interface.
+func (v *ComponentTypeFields) GetId() string { return v.Id }
+
+// GetName returns ComponentTypeFields.Name, and is useful for accessing the field via an interface.
+func (v *ComponentTypeFields) GetName() string { return v.Name }
+
+// GetDescription returns ComponentTypeFields.Description, and is useful for accessing the field via an interface.
+func (v *ComponentTypeFields) GetDescription() string { return v.Description }
+
+// GetIconUrl returns ComponentTypeFields.IconUrl, and is useful for accessing the field via an interface.
+func (v *ComponentTypeFields) GetIconUrl() string { return v.IconUrl }
+
+type CreateCompassComponentInput struct {
+	Name         string                   `json:"name"`
+	Description  string                   `json:"description,omitempty"`
+	Type         CompassComponentType     `json:"type,omitempty"`
+	TypeId       string                   `json:"typeId,omitempty"`
+	OwnerId      string                   `json:"ownerId,omitempty"`
+	CustomFields []map[string]interface{} `json:"customFields,omitempty"`
+}
+
+// GetName returns CreateCompassComponentInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentInput) GetName() string { return v.Name }
+
+// GetDescription returns CreateCompassComponentInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentInput) GetDescription() string { return v.Description }
+
+// GetType returns CreateCompassComponentInput.Type, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentInput) GetType() CompassComponentType { return v.Type }
+
+// GetTypeId returns CreateCompassComponentInput.TypeId, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentInput) GetTypeId() string { return v.TypeId }
+
+// GetOwnerId returns CreateCompassComponentInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentInput) GetOwnerId() string { return v.OwnerId }
+
+// GetCustomFields returns CreateCompassComponentInput.CustomFields, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentInput) GetCustomFields() []map[string]interface{} {
+	return v.CustomFields
+}
+
+type CreateCompassComponentLinkInput struct {
+	ComponentId string                 `json:"componentId"`
+	Link        CreateCompassLinkInput `json:"link"`
+}
+
+// GetComponentId returns CreateCompassComponentLinkInput.ComponentId, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentLinkInput) GetComponentId() string { return v.ComponentId }
+
+// GetLink returns CreateCompassComponentLinkInput.Link, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentLinkInput) GetLink() CreateCompassLinkInput { return v.Link }
+
+type CreateCompassComponentTypeInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IconKey     string `json:"iconKey,omitempty"`
+}
+
+// GetName returns CreateCompassComponentTypeInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentTypeInput) GetName() string { return v.Name }
+
+// GetDescription returns CreateCompassComponentTypeInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentTypeInput) GetDescription() string { return v.Description }
+
+// GetIconKey returns CreateCompassComponentTypeInput.IconKey, and is useful for accessing the field via an interface.
+func (v *CreateCompassComponentTypeInput) GetIconKey() string { return v.IconKey }
+
+type CreateCompassLinkInput struct {
+	Name     string          `json:"name"`
+	Type     CompassLinkType `json:"type"`
+	Url      string          `json:"url"`
+	ObjectId string          `json:"objectId,omitempty"`
+}
+
+// GetName returns CreateCompassLinkInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateCompassLinkInput) GetName() string { return v.Name }
+
+// GetType returns CreateCompassLinkInput.Type, and is useful for accessing the field via an interface.
+func (v *CreateCompassLinkInput) GetType() CompassLinkType { return v.Type }
+
+// GetUrl returns CreateCompassLinkInput.Url, and is useful for accessing the field via an interface.
+func (v *CreateCompassLinkInput) GetUrl() string { return v.Url }
+
+// GetObjectId returns CreateCompassLinkInput.ObjectId, and is useful for accessing the field via an interface.
+func (v *CreateCompassLinkInput) GetObjectId() string { return v.ObjectId }
+
+type CreateCompassRelationshipInput struct {
+	StartNodeId string                  `json:"startNodeId"`
+	EndNodeId   string                  `json:"endNodeId"`
+	Type        CompassRelationshipType `json:"type"`
+}
+
+// GetStartNodeId returns CreateCompassRelationshipInput.StartNodeId, and is useful for accessing the field via an interface.
+func (v *CreateCompassRelationshipInput) GetStartNodeId() string { return v.StartNodeId }
+
+// GetEndNodeId returns CreateCompassRelationshipInput.EndNodeId, and is useful for accessing the field via an interface.
+func (v *CreateCompassRelationshipInput) GetEndNodeId() string { return v.EndNodeId }
+
+// GetType returns CreateCompassRelationshipInput.Type, and is useful for accessing the field via an interface.
+func (v *CreateCompassRelationshipInput) GetType() CompassRelationshipType { return v.Type }
+
+type CreateCompassScorecardInput struct {
+	Name             string                     `json:"name"`
+	Description      string                     `json:"description,omitempty"`
+	OwnerId          string                     `json:"ownerId,omitempty"`
+	Importance       CompassScorecardImportance `json:"importance"`
+	ComponentTypeIds []string                   `json:"componentTypeIds"`
+	Criterias        []map[string]interface{}   `json:"criterias"`
+}
+
+// GetName returns CreateCompassScorecardInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateCompassScorecardInput) GetName() string { return v.Name }
+
+// GetDescription returns CreateCompassScorecardInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateCompassScorecardInput) GetDescription() string { return v.Description }
+
+// GetOwnerId returns CreateCompassScorecardInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CreateCompassScorecardInput) GetOwnerId() string { return v.OwnerId }
+
+// GetImportance returns CreateCompassScorecardInput.Importance, and is useful for accessing the field via an interface.
+func (v *CreateCompassScorecardInput) GetImportance() CompassScorecardImportance { return v.Importance }
+
+// GetComponentTypeIds returns CreateCompassScorecardInput.ComponentTypeIds, and is useful for accessing the field via an interface.
+func (v *CreateCompassScorecardInput) GetComponentTypeIds() []string { return v.ComponentTypeIds }
+
+// GetCriterias returns CreateCompassScorecardInput.Criterias, and is useful for accessing the field via an interface.
+func (v *CreateCompassScorecardInput) GetCriterias() []map[string]interface{} { return v.Criterias }
+
+// CreateComponentCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type CreateComponentCompassCompassCatalogMutationApi struct {
+	CreateComponent CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload `json:"createComponent"`
+}
+
+// GetCreateComponent returns CreateComponentCompassCompassCatalogMutationApi.CreateComponent, and is useful for accessing the field via an interface.
+func (v *CreateComponentCompassCompassCatalogMutationApi) GetCreateComponent() CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload {
+	return v.CreateComponent
+}
+
+// CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload includes the requested fields of the GraphQL type CreateCompassComponentPayload.
+type CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload struct {
+	Success          bool                                                                                                                        `json:"success"`
+	Errors           []MutationErrorFields                                                                                                       `json:"errors"`
+	ComponentDetails CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayloadComponentDetailsCompassComponent `json:"componentDetails"`
+}
+
+// GetSuccess returns CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload.Errors, and is useful for accessing the field via an interface.
+func (v *CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// GetComponentDetails returns CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload.ComponentDetails, and is useful for accessing the field via an interface.
+func (v *CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayload) GetComponentDetails() CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayloadComponentDetailsCompassComponent {
+	return v.ComponentDetails
+}
+
+// CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayloadComponentDetailsCompassComponent includes the requested fields of the GraphQL type CompassComponent.
+type CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayloadComponentDetailsCompassComponent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayloadComponentDetailsCompassComponent.Id, and is useful for accessing the field via an interface.
+func (v *CreateComponentCompassCompassCatalogMutationApiCreateComponentCreateCompassComponentPayloadComponentDetailsCompassComponent) GetId() string {
+	return v.Id
+}
+
+// CreateComponentLinkCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type CreateComponentLinkCompassCompassCatalogMutationApi struct {
+	CreateComponentLink CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload `json:"createComponentLink"`
+}
+
+// GetCreateComponentLink returns CreateComponentLinkCompassCompassCatalogMutationApi.CreateComponentLink, and is useful for accessing the field via an interface.
+func (v *CreateComponentLinkCompassCompassCatalogMutationApi) GetCreateComponentLink() CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload {
+	return v.CreateComponentLink
+}
+
+// CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload includes the requested fields of the GraphQL type CreateCompassComponentLinkPayload.
+type CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload struct {
+	Success              bool                  `json:"success"`
+	CreatedComponentLink *ComponentLinkFields  `json:"createdComponentLink"`
+	Errors               []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetCreatedComponentLink returns CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload.CreatedComponentLink, and is useful for accessing the field via an interface.
+func (v *CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload) GetCreatedComponentLink() *ComponentLinkFields {
+	return v.CreatedComponentLink
+}
+
+// GetErrors returns CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload.Errors, and is useful for accessing the field via an interface.
+func (v *CreateComponentLinkCompassCompassCatalogMutationApiCreateComponentLinkCreateCompassComponentLinkPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// CreateComponentLinkResponse is returned by CreateComponentLink on success.
+type CreateComponentLinkResponse struct {
+	Compass CreateComponentLinkCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns CreateComponentLinkResponse.Compass, and is useful for accessing the field via an interface.
+func (v *CreateComponentLinkResponse) GetCompass() CreateComponentLinkCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// CreateComponentResponse is returned by CreateComponent on success.
+type CreateComponentResponse struct {
+	Compass CreateComponentCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns CreateComponentResponse.Compass, and is useful for accessing the field via an interface.
+func (v *CreateComponentResponse) GetCompass() CreateComponentCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// CreateComponentTypeCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type CreateComponentTypeCompassCompassCatalogMutationApi struct {
+	CreateComponentType CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload `json:"createComponentType"`
+}
+
+// GetCreateComponentType returns CreateComponentTypeCompassCompassCatalogMutationApi.CreateComponentType, and is useful for accessing the field via an interface.
+func (v *CreateComponentTypeCompassCompassCatalogMutationApi) GetCreateComponentType() CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload {
+	return v.CreateComponentType
+}
+
+// CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload includes the requested fields of the GraphQL type CreateCompassComponentTypePayload.
+type CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload struct {
+	Success              bool                                                                                                                                                  `json:"success"`
+	Errors               []MutationErrorFields                                                                                                                                 `json:"errors"`
+	CreatedComponentType CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayloadCreatedComponentTypeCompassComponentTypeObject `json:"createdComponentType"`
+}
+
+// GetSuccess returns CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload.Errors, and is useful for accessing the field via an interface.
+func (v *CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// GetCreatedComponentType returns CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload.CreatedComponentType, and is useful for accessing the field via an interface.
+func (v *CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayload) GetCreatedComponentType() CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayloadCreatedComponentTypeCompassComponentTypeObject {
+	return v.CreatedComponentType
+}
+
+// CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayloadCreatedComponentTypeCompassComponentTypeObject includes the requested fields of the GraphQL type CompassComponentTypeObject.
+type CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayloadCreatedComponentTypeCompassComponentTypeObject struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayloadCreatedComponentTypeCompassComponentTypeObject.Id, and is useful for accessing the field via an interface.
+func (v *CreateComponentTypeCompassCompassCatalogMutationApiCreateComponentTypeCreateCompassComponentTypePayloadCreatedComponentTypeCompassComponentTypeObject) GetId() string {
+	return v.Id
+}
+
+// CreateComponentTypeResponse is returned by CreateComponentType on success.
+type CreateComponentTypeResponse struct {
+	Compass CreateComponentTypeCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns CreateComponentTypeResponse.Compass, and is useful for accessing the field via an interface.
+func (v *CreateComponentTypeResponse) GetCompass() CreateComponentTypeCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// CreateCustomFieldDefinitionCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type CreateCustomFieldDefinitionCompassCompassCatalogMutationApi struct {
+	CreateCustomFieldDefinition CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload `json:"createCustomFieldDefinition"`
+}
+
+// GetCreateCustomFieldDefinition returns CreateCustomFieldDefinitionCompassCompassCatalogMutationApi.CreateCustomFieldDefinition, and is useful for accessing the field via an interface.
+func (v *CreateCustomFieldDefinitionCompassCompassCatalogMutationApi) GetCreateCustomFieldDefinition() CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload {
+	return v.CreateCustomFieldDefinition
+}
+
+// CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload includes the requested fields of the GraphQL type CompassCreateCustomFieldDefinitionPayload.
+type CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload struct {
+	Success               bool                         `json:"success"`
+	Errors                []MutationErrorFields        `json:"errors"`
+	CustomFieldDefinition CreatedCustomFieldDefinition `json:"-"`
+}
+
+// GetSuccess returns CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload.Errors, and is useful for accessing the field via an interface.
+func (v *CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// GetCustomFieldDefinition returns CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload.CustomFieldDefinition, and is useful for accessing the field via an interface.
+func (v *CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload) GetCustomFieldDefinition() CreatedCustomFieldDefinition {
+	return v.CustomFieldDefinition
+}
+
+func (v *CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload
+		CustomFieldDefinition json.RawMessage `json:"customFieldDefinition"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.CustomFieldDefinition
+		src := firstPass.CustomFieldDefinition
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalCreatedCustomFieldDefinition(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload.CustomFieldDefinition: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalCreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload struct {
+	Success bool `json:"success"`
+
+	Errors []MutationErrorFields `json:"errors"`
+
+	CustomFieldDefinition json.RawMessage `json:"customFieldDefinition"`
+}
+
+func (v *CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload) __premarshalJSON() (*__premarshalCreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload, error) {
+	var retval __premarshalCreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload
+
+	retval.Success = v.Success
+	retval.Errors = v.Errors
+	{
+
+		dst := &retval.CustomFieldDefinition
+		src := v.CustomFieldDefinition
+		var err error
+		*dst, err = __marshalCreatedCustomFieldDefinition(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal CreateCustomFieldDefinitionCompassCompassCatalogMutationApiCreateCustomFieldDefinitionCompassCreateCustomFieldDefinitionPayload.CustomFieldDefinition: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// CreateCustomFieldDefinitionResponse is returned by CreateCustomFieldDefinition on success.
+type CreateCustomFieldDefinitionResponse struct {
+	Compass CreateCustomFieldDefinitionCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns CreateCustomFieldDefinitionResponse.Compass, and is useful for accessing the field via an interface.
+func (v *CreateCustomFieldDefinitionResponse) GetCompass() CreateCustomFieldDefinitionCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// CreateRelationshipCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type CreateRelationshipCompassCompassCatalogMutationApi struct {
+	CreateRelationship CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload `json:"createRelationship"`
+}
+
+// GetCreateRelationship returns CreateRelationshipCompassCompassCatalogMutationApi.CreateRelationship, and is useful for accessing the field via an interface.
+func (v *CreateRelationshipCompassCompassCatalogMutationApi) GetCreateRelationship() CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload {
+	return v.CreateRelationship
+}
+
+// CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload includes the requested fields of the GraphQL type CreateCompassRelationshipPayload.
+type CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload.Errors, and is useful for accessing the field via an interface.
+func (v *CreateRelationshipCompassCompassCatalogMutationApiCreateRelationshipCreateCompassRelationshipPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// CreateRelationshipResponse is returned by CreateRelationship on success.
+type CreateRelationshipResponse struct {
+	Compass CreateRelationshipCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns CreateRelationshipResponse.Compass, and is useful for accessing the field via an interface.
+func (v *CreateRelationshipResponse) GetCompass() CreateRelationshipCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// CreateScorecardCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type CreateScorecardCompassCompassCatalogMutationApi struct {
+	CreateScorecard CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload `json:"createScorecard"`
+}
+
+// GetCreateScorecard returns CreateScorecardCompassCompassCatalogMutationApi.CreateScorecard, and is useful for accessing the field via an interface.
+func (v *CreateScorecardCompassCompassCatalogMutationApi) GetCreateScorecard() CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload {
+	return v.CreateScorecard
+}
+
+// CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload includes the requested fields of the GraphQL type CreateCompassScorecardPayload.
+type CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload struct {
+	Success          bool                                                                                                                        `json:"success"`
+	Errors           []MutationErrorFields                                                                                                       `json:"errors"`
+	ScorecardDetails CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayloadScorecardDetailsCompassScorecard `json:"scorecardDetails"`
+}
+
+// GetSuccess returns CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload.Errors, and is useful for accessing the field via an interface.
+func (v *CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// GetScorecardDetails returns CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload.ScorecardDetails, and is useful for accessing the field via an interface.
+func (v *CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayload) GetScorecardDetails() CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayloadScorecardDetailsCompassScorecard {
+	return v.ScorecardDetails
+}
+
+// CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayloadScorecardDetailsCompassScorecard includes the requested fields of the GraphQL type CompassScorecard.
+type CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayloadScorecardDetailsCompassScorecard struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayloadScorecardDetailsCompassScorecard.Id, and is useful for accessing the field via an interface.
+func (v *CreateScorecardCompassCompassCatalogMutationApiCreateScorecardCreateCompassScorecardPayloadScorecardDetailsCompassScorecard) GetId() string {
+	return v.Id
+}
+
+// CreateScorecardResponse is returned by CreateScorecard on success.
+type CreateScorecardResponse struct {
+	Compass CreateScorecardCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns CreateScorecardResponse.Compass, and is useful for accessing the field via an interface.
+func (v *CreateScorecardResponse) GetCompass() CreateScorecardCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// CreatedCustomFieldDefinition includes the requested fields of the GraphQL interface CompassCustomFieldDefinition.
+//
+// CreatedCustomFieldDefinition is implemented by the following types:
+// CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition
+// CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition
+// CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition
+// CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition
+// CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition
+// CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition
+type CreatedCustomFieldDefinition interface {
+	implementsGraphQLInterfaceCreatedCustomFieldDefinition()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
+}
+
+func (v *CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition) implementsGraphQLInterfaceCreatedCustomFieldDefinition() {
+}
+func (v *CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition) implementsGraphQLInterfaceCreatedCustomFieldDefinition() {
+}
+func (v *CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition) implementsGraphQLInterfaceCreatedCustomFieldDefinition() {
+}
+func (v *CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition) implementsGraphQLInterfaceCreatedCustomFieldDefinition() {
+}
+func (v *CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition) implementsGraphQLInterfaceCreatedCustomFieldDefinition() {
+}
+func (v *CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition) implementsGraphQLInterfaceCreatedCustomFieldDefinition() {
+}
+
+func __unmarshalCreatedCustomFieldDefinition(b []byte, v *CreatedCustomFieldDefinition) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassCustomBooleanFieldDefinition":
+		*v = new(CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition)
+		return json.Unmarshal(b, *v)
+	case "CompassCustomMultiSelectFieldDefinition":
+		*v = new(CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition)
+		return json.Unmarshal(b, *v)
+	case "CompassCustomNumberFieldDefinition":
+		*v = new(CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition)
+		return json.Unmarshal(b, *v)
+	case "CompassCustomSingleSelectFieldDefinition":
+		*v = new(CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition)
+		return json.Unmarshal(b, *v)
+	case "CompassCustomTextFieldDefinition":
+		*v = new(CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition)
+		return json.Unmarshal(b, *v)
+	case "CompassCustomUserFieldDefinition":
+		*v = new(CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassCustomFieldDefinition.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for CreatedCustomFieldDefinition: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalCreatedCustomFieldDefinition(v *CreatedCustomFieldDefinition) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition:
+		typename = "CompassCustomBooleanFieldDefinition"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition
+		}{typename, v}
+		return json.Marshal(result)
+	case *CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition:
+		typename = "CompassCustomMultiSelectFieldDefinition"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition
+		}{typename, v}
+		return json.Marshal(result)
+	case *CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition:
+		typename = "CompassCustomNumberFieldDefinition"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition
+		}{typename, v}
+		return json.Marshal(result)
+	case *CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition:
+		typename = "CompassCustomSingleSelectFieldDefinition"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition
+		}{typename, v}
+		return json.Marshal(result)
+	case *CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition:
+		typename = "CompassCustomTextFieldDefinition"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition
+		}{typename, v}
+		return json.Marshal(result)
+	case *CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition:
+		typename = "CompassCustomUserFieldDefinition"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for CreatedCustomFieldDefinition: "%T"`, v)
+	}
+}
+
+// CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition includes the requested fields of the GraphQL type CompassCustomBooleanFieldDefinition.
+type CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
+
+// GetTypename returns CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition.Typename, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomBooleanFieldDefinition) GetId() string { return v.Id }
+
+// CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition includes the requested fields of the GraphQL type CompassCustomMultiSelectFieldDefinition.
+type CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
+
+// GetTypename returns CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition.Typename, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomMultiSelectFieldDefinition) GetId() string {
+	return v.Id
+}
+
+// CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition includes the requested fields of the GraphQL type CompassCustomNumberFieldDefinition.
+type CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
+
+// GetTypename returns CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition.Typename, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomNumberFieldDefinition) GetId() string { return v.Id }
+
+// CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition includes the requested fields of the GraphQL type CompassCustomSingleSelectFieldDefinition.
+type CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
+
+// GetTypename returns CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition.Typename, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomSingleSelectFieldDefinition) GetId() string {
+	return v.Id
+}
+
+// CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition includes the requested fields of the GraphQL type CompassCustomTextFieldDefinition.
+type CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
+
+// GetTypename returns CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition.Typename, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomTextFieldDefinition) GetId() string { return v.Id }
+
+// CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition includes the requested fields of the GraphQL type CompassCustomUserFieldDefinition.
+type CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
+
+// GetTypename returns CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition.Typename, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreatedCustomFieldDefinitionCompassCustomUserFieldDefinition) GetId() string { return v.Id }
+
+type DeleteCompassComponentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns DeleteCompassComponentInput.Id, and is useful for accessing the field via an interface.
+func (v *DeleteCompassComponentInput) GetId() string { return v.Id }
+
+type DeleteCompassComponentLinkInput struct {
+	ComponentId string `json:"componentId"`
+	Link        string `json:"link"`
+}
+
+// GetComponentId returns DeleteCompassComponentLinkInput.ComponentId, and is useful for accessing the field via an interface.
+func (v *DeleteCompassComponentLinkInput) GetComponentId() string { return v.ComponentId }
+
+// GetLink returns DeleteCompassComponentLinkInput.Link, and is useful for accessing the field via an interface.
+func (v *DeleteCompassComponentLinkInput) GetLink() string { return v.Link }
+
+type DeleteCompassComponentTypeInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns DeleteCompassComponentTypeInput.Id, and is useful for accessing the field via an interface.
+func (v *DeleteCompassComponentTypeInput) GetId() string { return v.Id }
+
+type DeleteCompassRelationshipInput struct {
+	StartNodeId string                  `json:"startNodeId"`
+	EndNodeId   string                  `json:"endNodeId"`
+	Type        CompassRelationshipType `json:"type"`
+}
+
+// GetStartNodeId returns DeleteCompassRelationshipInput.StartNodeId, and is useful for accessing the field via an interface.
+func (v *DeleteCompassRelationshipInput) GetStartNodeId() string { return v.StartNodeId }
+
+// GetEndNodeId returns DeleteCompassRelationshipInput.EndNodeId, and is useful for accessing the field via an interface.
+func (v *DeleteCompassRelationshipInput) GetEndNodeId() string { return v.EndNodeId }
+
+// GetType returns DeleteCompassRelationshipInput.Type, and is useful for accessing the field via an interface.
+func (v *DeleteCompassRelationshipInput) GetType() CompassRelationshipType { return v.Type }
+
+type DeleteCompassScorecardCriteriaInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns DeleteCompassScorecardCriteriaInput.Id, and is useful for accessing the field via an interface.
+func (v *DeleteCompassScorecardCriteriaInput) GetId() string { return v.Id }
+
+// DeleteComponentCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type DeleteComponentCompassCompassCatalogMutationApi struct {
+	DeleteComponent DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload `json:"deleteComponent"`
+}
+
+// GetDeleteComponent returns DeleteComponentCompassCompassCatalogMutationApi.DeleteComponent, and is useful for accessing the field via an interface.
+func (v *DeleteComponentCompassCompassCatalogMutationApi) GetDeleteComponent() DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload {
+	return v.DeleteComponent
+}
+
+// DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload includes the requested fields of the GraphQL type DeleteCompassComponentPayload.
+type DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload.Success, and is useful for accessing the field via an interface.
+func (v *DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload.Errors, and is useful for accessing the field via an interface.
+func (v *DeleteComponentCompassCompassCatalogMutationApiDeleteComponentDeleteCompassComponentPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// DeleteComponentLinkCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type DeleteComponentLinkCompassCompassCatalogMutationApi struct {
+	DeleteComponentLink DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload `json:"deleteComponentLink"`
+}
+
+// GetDeleteComponentLink returns DeleteComponentLinkCompassCompassCatalogMutationApi.DeleteComponentLink, and is useful for accessing the field via an interface.
+func (v *DeleteComponentLinkCompassCompassCatalogMutationApi) GetDeleteComponentLink() DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload {
+	return v.DeleteComponentLink
+}
+
+// DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload includes the requested fields of the GraphQL type DeleteCompassComponentLinkPayload.
+type DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload.Success, and is useful for accessing the field via an interface.
+func (v *DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload.Errors, and is useful for accessing the field via an interface.
+func (v *DeleteComponentLinkCompassCompassCatalogMutationApiDeleteComponentLinkDeleteCompassComponentLinkPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// DeleteComponentLinkResponse is returned by DeleteComponentLink on success.
+type DeleteComponentLinkResponse struct {
+	Compass DeleteComponentLinkCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns DeleteComponentLinkResponse.Compass, and is useful for accessing the field via an interface.
+func (v *DeleteComponentLinkResponse) GetCompass() DeleteComponentLinkCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// DeleteComponentResponse is returned by DeleteComponent on success.
+type DeleteComponentResponse struct {
+	Compass DeleteComponentCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns DeleteComponentResponse.Compass, and is useful for accessing the field via an interface.
+func (v *DeleteComponentResponse) GetCompass() DeleteComponentCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// DeleteComponentTypeCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type DeleteComponentTypeCompassCompassCatalogMutationApi struct {
+	DeleteComponentType DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload `json:"deleteComponentType"`
+}
+
+// GetDeleteComponentType returns DeleteComponentTypeCompassCompassCatalogMutationApi.DeleteComponentType, and is useful for accessing the field via an interface.
+func (v *DeleteComponentTypeCompassCompassCatalogMutationApi) GetDeleteComponentType() DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload {
+	return v.DeleteComponentType
+}
+
+// DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload includes the requested fields of the GraphQL type DeleteCompassComponentTypePayload.
+type DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload.Success, and is useful for accessing the field via an interface.
+func (v *DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload.Errors, and is useful for accessing the field via an interface.
+func (v *DeleteComponentTypeCompassCompassCatalogMutationApiDeleteComponentTypeDeleteCompassComponentTypePayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// DeleteComponentTypeResponse is returned by DeleteComponentType on success.
+type DeleteComponentTypeResponse struct {
+	Compass DeleteComponentTypeCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns DeleteComponentTypeResponse.Compass, and is useful for accessing the field via an interface.
+func (v *DeleteComponentTypeResponse) GetCompass() DeleteComponentTypeCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// DeleteCustomFieldDefinitionCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type DeleteCustomFieldDefinitionCompassCompassCatalogMutationApi struct {
+	DeleteCustomFieldDefinition DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload `json:"deleteCustomFieldDefinition"`
+}
+
+// GetDeleteCustomFieldDefinition returns DeleteCustomFieldDefinitionCompassCompassCatalogMutationApi.DeleteCustomFieldDefinition, and is useful for accessing the field via an interface.
+func (v *DeleteCustomFieldDefinitionCompassCompassCatalogMutationApi) GetDeleteCustomFieldDefinition() DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload {
+	return v.DeleteCustomFieldDefinition
+}
+
+// DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload includes the requested fields of the GraphQL type CompassDeleteCustomFieldDefinitionPayload.
+type DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload.Success, and is useful for accessing the field via an interface.
+func (v *DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload.Errors, and is useful for accessing the field via an interface.
+func (v *DeleteCustomFieldDefinitionCompassCompassCatalogMutationApiDeleteCustomFieldDefinitionCompassDeleteCustomFieldDefinitionPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// DeleteCustomFieldDefinitionResponse is returned by DeleteCustomFieldDefinition on success.
+type DeleteCustomFieldDefinitionResponse struct {
+	Compass DeleteCustomFieldDefinitionCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns DeleteCustomFieldDefinitionResponse.Compass, and is useful for accessing the field via an interface.
+func (v *DeleteCustomFieldDefinitionResponse) GetCompass() DeleteCustomFieldDefinitionCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// DeleteRelationshipCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type DeleteRelationshipCompassCompassCatalogMutationApi struct {
+	DeleteRelationship DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload `json:"deleteRelationship"`
+}
+
+// GetDeleteRelationship returns DeleteRelationshipCompassCompassCatalogMutationApi.DeleteRelationship, and is useful for accessing the field via an interface.
+func (v *DeleteRelationshipCompassCompassCatalogMutationApi) GetDeleteRelationship() DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload {
+	return v.DeleteRelationship
+}
+
+// DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload includes the requested fields of the GraphQL type DeleteCompassRelationshipPayload.
+type DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload.Success, and is useful for accessing the field via an interface.
+func (v *DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload.Errors, and is useful for accessing the field via an interface.
+func (v *DeleteRelationshipCompassCompassCatalogMutationApiDeleteRelationshipDeleteCompassRelationshipPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// DeleteRelationshipResponse is returned by DeleteRelationship on success.
+type DeleteRelationshipResponse struct {
+	Compass DeleteRelationshipCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns DeleteRelationshipResponse.Compass, and is useful for accessing the field via an interface.
+func (v *DeleteRelationshipResponse) GetCompass() DeleteRelationshipCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// DeleteScorecardCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type DeleteScorecardCompassCompassCatalogMutationApi struct {
+	DeleteScorecard DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload `json:"deleteScorecard"`
+}
+
+// GetDeleteScorecard returns DeleteScorecardCompassCompassCatalogMutationApi.DeleteScorecard, and is useful for accessing the field via an interface.
+func (v *DeleteScorecardCompassCompassCatalogMutationApi) GetDeleteScorecard() DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload {
+	return v.DeleteScorecard
+}
+
+// DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload includes the requested fields of the GraphQL type DeleteCompassScorecardPayload.
+type DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload.Success, and is useful for accessing the field via an interface.
+func (v *DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload.Errors, and is useful for accessing the field via an interface.
+func (v *DeleteScorecardCompassCompassCatalogMutationApiDeleteScorecardDeleteCompassScorecardPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// DeleteScorecardResponse is returned by DeleteScorecard on success.
+type DeleteScorecardResponse struct {
+	Compass DeleteScorecardCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns DeleteScorecardResponse.Compass, and is useful for accessing the field via an interface.
+func (v *DeleteScorecardResponse) GetCompass() DeleteScorecardCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// GetComponentCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type GetComponentCompassCompassCatalogQueryApi struct {
+	Component GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult `json:"-"`
+}
+
+// GetComponent returns GetComponentCompassCompassCatalogQueryApi.Component, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApi) GetComponent() GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult {
+	return v.Component
+}
+
+func (v *GetComponentCompassCompassCatalogQueryApi) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetComponentCompassCompassCatalogQueryApi
+		Component json.RawMessage `json:"component"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetComponentCompassCompassCatalogQueryApi = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Component
+		src := firstPass.Component
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetComponentCompassCompassCatalogQueryApi.Component: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetComponentCompassCompassCatalogQueryApi struct {
+	Component json.RawMessage `json:"component"`
+}
+
+func (v *GetComponentCompassCompassCatalogQueryApi) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetComponentCompassCompassCatalogQueryApi) __premarshalJSON() (*__premarshalGetComponentCompassCompassCatalogQueryApi, error) {
+	var retval __premarshalGetComponentCompassCompassCatalogQueryApi
+
+	{
+
+		dst := &retval.Component
+		src := v.Component
+		var err error
+		*dst, err = __marshalGetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetComponentCompassCompassCatalogQueryApi.Component: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetComponentCompassCompassCatalogQueryApiComponentCompassComponent includes the requested fields of the GraphQL type CompassComponent.
+type GetComponentCompassCompassCatalogQueryApiComponentCompassComponent struct {
+	Typename     string                                                                                          `json:"__typename"`
+	Id           string                                                                                          `json:"id"`
+	Name         string                                                                                          `json:"name"`
+	Slug         string                                                                                          `json:"slug"`
+	Description  string                                                                                          `json:"description"`
+	TypeId       string                                                                                          `json:"typeId"`
+	OwnerId      string                                                                                          `json:"ownerId"`
+	Labels       []GetComponentCompassCompassCatalogQueryApiComponentCompassComponentLabelsCompassComponentLabel `json:"labels"`
+	Links        []ComponentLinkFields                                                                           `json:"links"`
+	CustomFields []CustomField                                                                                   `json:"customFields"`
+}
+
+// GetTypename returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.Name, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetName() string {
+	return v.Name
+}
+
+// GetSlug returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.Slug, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetSlug() string {
+	return v.Slug
+}
+
+// GetDescription returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.Description, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetDescription() string {
+	return v.Description
+}
+
+// GetTypeId returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.TypeId, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetTypeId() string {
+	return v.TypeId
+}
+
+// GetOwnerId returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.OwnerId, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetOwnerId() string {
+	return v.OwnerId
+}
+
+// GetLabels returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.Labels, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetLabels() []GetComponentCompassCompassCatalogQueryApiComponentCompassComponentLabelsCompassComponentLabel {
+	return v.Labels
+}
+
+// GetLinks returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.Links, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetLinks() []ComponentLinkFields {
+	return v.Links
+}
+
+// GetCustomFields returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponent.CustomFields, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) GetCustomFields() []CustomField {
+	return v.CustomFields
+}
+
+// GetComponentCompassCompassCatalogQueryApiComponentCompassComponentLabelsCompassComponentLabel includes the requested fields of the GraphQL type CompassComponentLabel.
+type GetComponentCompassCompassCatalogQueryApiComponentCompassComponentLabelsCompassComponentLabel struct {
+	Name string `json:"name"`
+}
+
+// GetName returns GetComponentCompassCompassCatalogQueryApiComponentCompassComponentLabelsCompassComponentLabel.Name, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponentLabelsCompassComponentLabel) GetName() string {
+	return v.Name
+}
+
+// GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult includes the requested fields of the GraphQL interface CompassComponentResult.
+//
+// GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult is implemented by the following types:
+// GetComponentCompassCompassCatalogQueryApiComponentCompassComponent
+// GetComponentCompassCompassCatalogQueryApiComponentQueryError
+type GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult interface {
+	implementsGraphQLInterfaceGetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent) implementsGraphQLInterfaceGetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult() {
+}
+func (v *GetComponentCompassCompassCatalogQueryApiComponentQueryError) implementsGraphQLInterfaceGetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult() {
+}
+
+func __unmarshalGetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult(b []byte, v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassComponent":
+		*v = new(GetComponentCompassCompassCatalogQueryApiComponentCompassComponent)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(GetComponentCompassCompassCatalogQueryApiComponentQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassComponentResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult(v *GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetComponentCompassCompassCatalogQueryApiComponentCompassComponent:
+		typename = "CompassComponent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentCompassCompassCatalogQueryApiComponentCompassComponent
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetComponentCompassCompassCatalogQueryApiComponentQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentCompassCompassCatalogQueryApiComponentQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetComponentCompassCompassCatalogQueryApiComponentCompassComponentResult: "%T"`, v)
+	}
+}
+
+// GetComponentCompassCompassCatalogQueryApiComponentQueryError includes the requested fields of the GraphQL type QueryError.
+type GetComponentCompassCompassCatalogQueryApiComponentQueryError struct {
+	Typename   string           `json:"__typename"`
+	Message    string           `json:"message"`
+	Extensions []ErrorExtension `json:"extensions"`
+}
+
+// GetTypename returns GetComponentCompassCompassCatalogQueryApiComponentQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentQueryError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns GetComponentCompassCompassCatalogQueryApiComponentQueryError.Message, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentQueryError) GetMessage() string {
+	return v.Message
+}
+
+// GetExtensions returns GetComponentCompassCompassCatalogQueryApiComponentQueryError.Extensions, and is useful for accessing the field via an interface.
+func (v *GetComponentCompassCompassCatalogQueryApiComponentQueryError) GetExtensions() []ErrorExtension {
+	return v.Extensions
+}
+
+// GetComponentLinksCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type GetComponentLinksCompassCompassCatalogQueryApi struct {
+	Component GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult `json:"-"`
+}
+
+// GetComponent returns GetComponentLinksCompassCompassCatalogQueryApi.Component, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksCompassCompassCatalogQueryApi) GetComponent() GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult {
+	return v.Component
+}
+
+func (v *GetComponentLinksCompassCompassCatalogQueryApi) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetComponentLinksCompassCompassCatalogQueryApi
+		Component json.RawMessage `json:"component"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetComponentLinksCompassCompassCatalogQueryApi = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Component
+		src := firstPass.Component
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetComponentLinksCompassCompassCatalogQueryApi.Component: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetComponentLinksCompassCompassCatalogQueryApi struct {
+	Component json.RawMessage `json:"component"`
+}
+
+func (v *GetComponentLinksCompassCompassCatalogQueryApi) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetComponentLinksCompassCompassCatalogQueryApi) __premarshalJSON() (*__premarshalGetComponentLinksCompassCompassCatalogQueryApi, error) {
+	var retval __premarshalGetComponentLinksCompassCompassCatalogQueryApi
+
+	{
+
+		dst := &retval.Component
+		src := v.Component
+		var err error
+		*dst, err = __marshalGetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetComponentLinksCompassCompassCatalogQueryApi.Component: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent includes the requested fields of the GraphQL type CompassComponent.
+type GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent struct {
+	Typename string                `json:"__typename"`
+	Id       string                `json:"id"`
+	Links    []ComponentLinkFields `json:"links"`
+}
+
+// GetTypename returns GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent) GetId() string {
+	return v.Id
+}
+
+// GetLinks returns GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent.Links, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent) GetLinks() []ComponentLinkFields {
+	return v.Links
+}
+
+// GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult includes the requested fields of the GraphQL interface CompassComponentResult.
+//
+// GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult is implemented by the following types:
+// GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent
+// GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError
+type GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult interface {
+	implementsGraphQLInterfaceGetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent) implementsGraphQLInterfaceGetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult() {
+}
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError) implementsGraphQLInterfaceGetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult() {
+}
+
+func __unmarshalGetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult(b []byte, v *GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassComponent":
+		*v = new(GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassComponentResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult(v *GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent:
+		typename = "CompassComponent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponentResult: "%T"`, v)
+	}
+}
+
+// GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError includes the requested fields of the GraphQL type QueryError.
+type GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError struct {
+	Typename   string           `json:"__typename"`
+	Message    string           `json:"message"`
+	Extensions []ErrorExtension `json:"extensions"`
+}
+
+// GetTypename returns GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError.Message, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError) GetMessage() string {
+	return v.Message
+}
+
+// GetExtensions returns GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError.Extensions, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError) GetExtensions() []ErrorExtension {
+	return v.Extensions
+}
+
+// GetComponentLinksResponse is returned by GetComponentLinks on success.
+type GetComponentLinksResponse struct {
+	Compass GetComponentLinksCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns GetComponentLinksResponse.Compass, and is useful for accessing the field via an interface.
+func (v *GetComponentLinksResponse) GetCompass() GetComponentLinksCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// GetComponentRelationshipsCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type GetComponentRelationshipsCompassCompassCatalogQueryApi struct {
+	Component GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult `json:"-"`
+}
+
+// GetComponent returns GetComponentRelationshipsCompassCompassCatalogQueryApi.Component, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApi) GetComponent() GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult {
+	return v.Component
+}
+
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApi) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetComponentRelationshipsCompassCompassCatalogQueryApi
+		Component json.RawMessage `json:"component"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetComponentRelationshipsCompassCompassCatalogQueryApi = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Component
+		src := firstPass.Component
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetComponentRelationshipsCompassCompassCatalogQueryApi.Component: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetComponentRelationshipsCompassCompassCatalogQueryApi struct {
+	Component json.RawMessage `json:"component"`
+}
+
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApi) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApi) __premarshalJSON() (*__premarshalGetComponentRelationshipsCompassCompassCatalogQueryApi, error) {
+	var retval __premarshalGetComponentRelationshipsCompassCompassCatalogQueryApi
+
+	{
+
+		dst := &retval.Component
+		src := v.Component
+		var err error
+		*dst, err = __marshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetComponentRelationshipsCompassCompassCatalogQueryApi.Component: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent includes the requested fields of the GraphQL type CompassComponent.
+type GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent struct {
+	Typename      string                 `json:"__typename"`
+	Id            string                 `json:"id"`
+	Relationships ComponentRelationships `json:"-"`
+}
+
+// GetTypename returns GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent) GetId() string {
+	return v.Id
+}
+
+// GetRelationships returns GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent.Relationships, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent) GetRelationships() ComponentRelationships {
+	return v.Relationships
+}
+
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent
+		Relationships json.RawMessage `json:"relationships"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Relationships
+		src := firstPass.Relationships
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalComponentRelationships(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent.Relationships: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Relationships json.RawMessage `json:"relationships"`
+}
+
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent) __premarshalJSON() (*__premarshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent, error) {
+	var retval __premarshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	{
+
+		dst := &retval.Relationships
+		src := v.Relationships
+		var err error
+		*dst, err = __marshalComponentRelationships(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent.Relationships: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult includes the requested fields of the GraphQL interface CompassComponentResult.
+//
+// GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult is implemented by the following types:
+// GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent
+// GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError
+type GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult interface {
+	implementsGraphQLInterfaceGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent) implementsGraphQLInterfaceGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult() {
+}
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError) implementsGraphQLInterfaceGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult() {
+}
+
+func __unmarshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult(b []byte, v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassComponent":
+		*v = new(GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassComponentResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult(v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent:
+		typename = "CompassComponent"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponentResult: "%T"`, v)
+	}
+}
+
+// GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError includes the requested fields of the GraphQL type QueryError.
+type GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError struct {
+	Typename   string           `json:"__typename"`
+	Message    string           `json:"message"`
+	Extensions []ErrorExtension `json:"extensions"`
+}
+
+// GetTypename returns GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError.Message, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError) GetMessage() string {
+	return v.Message
+}
+
+// GetExtensions returns GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError.Extensions, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError) GetExtensions() []ErrorExtension {
+	return v.Extensions
+}
+
+// GetComponentRelationshipsResponse is returned by GetComponentRelationships on success.
+type GetComponentRelationshipsResponse struct {
+	Compass GetComponentRelationshipsCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns GetComponentRelationshipsResponse.Compass, and is useful for accessing the field via an interface.
+func (v *GetComponentRelationshipsResponse) GetCompass() GetComponentRelationshipsCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// GetComponentResponse is returned by GetComponent on success.
+type GetComponentResponse struct {
+	Compass GetComponentCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns GetComponentResponse.Compass, and is useful for accessing the field via an interface.
+func (v *GetComponentResponse) GetCompass() GetComponentCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// GetComponentTypeCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type GetComponentTypeCompassCompassCatalogQueryApi struct {
+	ComponentType GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult `json:"-"`
+}
+
+// GetComponentType returns GetComponentTypeCompassCompassCatalogQueryApi.ComponentType, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApi) GetComponentType() GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult {
+	return v.ComponentType
+}
+
+func (v *GetComponentTypeCompassCompassCatalogQueryApi) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetComponentTypeCompassCompassCatalogQueryApi
+		ComponentType json.RawMessage `json:"componentType"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetComponentTypeCompassCompassCatalogQueryApi = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.ComponentType
+		src := firstPass.ComponentType
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetComponentTypeCompassCompassCatalogQueryApi.ComponentType: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetComponentTypeCompassCompassCatalogQueryApi struct {
+	ComponentType json.RawMessage `json:"componentType"`
+}
+
+func (v *GetComponentTypeCompassCompassCatalogQueryApi) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetComponentTypeCompassCompassCatalogQueryApi) __premarshalJSON() (*__premarshalGetComponentTypeCompassCompassCatalogQueryApi, error) {
+	var retval __premarshalGetComponentTypeCompassCompassCatalogQueryApi
+
+	{
+
+		dst := &retval.ComponentType
+		src := v.ComponentType
+		var err error
+		*dst, err = __marshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetComponentTypeCompassCompassCatalogQueryApi.ComponentType: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject includes the requested fields of the GraphQL type CompassComponentTypeObject.
+type GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject struct {
+	Typename            string `json:"__typename"`
+	ComponentTypeFields `json:"-"`
+}
+
+// GetTypename returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject.Id, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) GetId() string {
+	return v.ComponentTypeFields.Id
+}
+
+// GetName returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject.Name, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) GetName() string {
+	return v.ComponentTypeFields.Name
+}
+
+// GetDescription returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject.Description, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) GetDescription() string {
+	return v.ComponentTypeFields.Description
+}
+
+// GetIconUrl returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject.IconUrl, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) GetIconUrl() string {
+	return v.ComponentTypeFields.IconUrl
+}
+
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ComponentTypeFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	IconUrl string `json:"iconUrl"`
+}
+
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) __premarshalJSON() (*__premarshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject, error) {
+	var retval __premarshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject
+
+	retval.Typename = v.Typename
+	retval.Id = v.ComponentTypeFields.Id
+	retval.Name = v.ComponentTypeFields.Name
+	retval.Description = v.ComponentTypeFields.Description
+	retval.IconUrl = v.ComponentTypeFields.IconUrl
+	return &retval, nil
+}
+
+// GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult includes the requested fields of the GraphQL interface CompassComponentTypeResult.
+//
+// GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult is implemented by the following types:
+// GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject
+// GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError
+type GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult interface {
+	implementsGraphQLInterfaceGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject) implementsGraphQLInterfaceGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult() {
+}
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError) implementsGraphQLInterfaceGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult() {
+}
+
+func __unmarshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult(b []byte, v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassComponentTypeObject":
+		*v = new(GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassComponentTypeResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult(v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject:
+		typename = "CompassComponentTypeObject"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeResult: "%T"`, v)
+	}
+}
+
+// GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError includes the requested fields of the GraphQL type QueryError.
+type GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError struct {
+	Typename   string           `json:"__typename"`
+	Message    string           `json:"message"`
+	Extensions []ErrorExtension `json:"extensions"`
+}
+
+// GetTypename returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError.Message, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError) GetMessage() string {
+	return v.Message
+}
+
+// GetExtensions returns GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError.Extensions, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError) GetExtensions() []ErrorExtension {
+	return v.Extensions
+}
+
+// GetComponentTypeResponse is returned by GetComponentType on success.
+type GetComponentTypeResponse struct {
+	Compass GetComponentTypeCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns GetComponentTypeResponse.Compass, and is useful for accessing the field via an interface.
+func (v *GetComponentTypeResponse) GetCompass() GetComponentTypeCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// GetComponentTypesCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type GetComponentTypesCompassCompassCatalogQueryApi struct {
+	ComponentTypes GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult `json:"-"`
+}
+
+// GetComponentTypes returns GetComponentTypesCompassCompassCatalogQueryApi.ComponentTypes, and is useful for accessing the field via an interface.
+func (v *GetComponentTypesCompassCompassCatalogQueryApi) GetComponentTypes() GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult {
+	return v.ComponentTypes
+}
+
+func (v *GetComponentTypesCompassCompassCatalogQueryApi) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetComponentTypesCompassCompassCatalogQueryApi
+		ComponentTypes json.RawMessage `json:"componentTypes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetComponentTypesCompassCompassCatalogQueryApi = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.ComponentTypes
+		src := firstPass.ComponentTypes
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetComponentTypesCompassCompassCatalogQueryApi.ComponentTypes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetComponentTypesCompassCompassCatalogQueryApi struct {
+	ComponentTypes json.RawMessage `json:"componentTypes"`
+}
+
+func (v *GetComponentTypesCompassCompassCatalogQueryApi) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetComponentTypesCompassCompassCatalogQueryApi) __premarshalJSON() (*__premarshalGetComponentTypesCompassCompassCatalogQueryApi, error) {
+	var retval __premarshalGetComponentTypesCompassCompassCatalogQueryApi
+
+	{
+
+		dst := &retval.ComponentTypes
+		src := v.ComponentTypes
+		var err error
+		*dst, err = __marshalGetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetComponentTypesCompassCompassCatalogQueryApi.ComponentTypes: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection includes the requested fields of the GraphQL type CompassComponentTypeConnection.
+type GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection struct {
+	Typename string                `json:"__typename"`
+	Nodes    []ComponentTypeFields `json:"nodes"`
+	PageInfo PageInfo              `json:"pageInfo"`
+}
+
+// GetTypename returns GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection) GetTypename() string {
+	return v.Typename
+}
+
+// GetNodes returns GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection) GetNodes() []ComponentTypeFields {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection) GetPageInfo() PageInfo {
+	return v.PageInfo
+}
+
+// GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult includes the requested fields of the GraphQL interface CompassComponentTypesQueryResult.
+//
+// GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult is implemented by the following types:
+// GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection
+// GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError
+type GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult interface {
+	implementsGraphQLInterfaceGetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection) implementsGraphQLInterfaceGetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult() {
+}
+func (v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError) implementsGraphQLInterfaceGetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult() {
+}
+
+func __unmarshalGetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult(b []byte, v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassComponentTypeConnection":
+		*v = new(GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassComponentTypesQueryResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult(v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection:
+		typename = "CompassComponentTypeConnection"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypesQueryResult: "%T"`, v)
+	}
+}
+
+// GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError includes the requested fields of the GraphQL type QueryError.
+type GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError.Message, and is useful for accessing the field via an interface.
+func (v *GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError) GetMessage() string {
+	return v.Message
+}
+
+// GetComponentTypesResponse is returned by GetComponentTypes on success.
+type GetComponentTypesResponse struct {
+	Compass GetComponentTypesCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns GetComponentTypesResponse.Compass, and is useful for accessing the field via an interface.
+func (v *GetComponentTypesResponse) GetCompass() GetComponentTypesCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// GetCustomFieldDefinitionCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type GetCustomFieldDefinitionCompassCompassCatalogQueryApi struct {
+	CustomFieldDefinition CustomFieldDefinitionResult `json:"customFieldDefinition"`
+}
+
+// GetCustomFieldDefinition returns GetCustomFieldDefinitionCompassCompassCatalogQueryApi.CustomFieldDefinition, and is useful for accessing the field via an interface.
+func (v *GetCustomFieldDefinitionCompassCompassCatalogQueryApi) GetCustomFieldDefinition() CustomFieldDefinitionResult {
+	return v.CustomFieldDefinition
+}
+
+// GetCustomFieldDefinitionResponse is returned by GetCustomFieldDefinition on success.
+type GetCustomFieldDefinitionResponse struct {
+	Compass GetCustomFieldDefinitionCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns GetCustomFieldDefinitionResponse.Compass, and is useful for accessing the field via an interface.
+func (v *GetCustomFieldDefinitionResponse) GetCompass() GetCustomFieldDefinitionCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// GetScorecardCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type GetScorecardCompassCompassCatalogQueryApi struct {
+	Scorecard GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult `json:"-"`
+}
+
+// GetScorecard returns GetScorecardCompassCompassCatalogQueryApi.Scorecard, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApi) GetScorecard() GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult {
+	return v.Scorecard
+}
+
+func (v *GetScorecardCompassCompassCatalogQueryApi) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetScorecardCompassCompassCatalogQueryApi
+		Scorecard json.RawMessage `json:"scorecard"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetScorecardCompassCompassCatalogQueryApi = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Scorecard
+		src := firstPass.Scorecard
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetScorecardCompassCompassCatalogQueryApi.Scorecard: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetScorecardCompassCompassCatalogQueryApi struct {
+	Scorecard json.RawMessage `json:"scorecard"`
+}
+
+func (v *GetScorecardCompassCompassCatalogQueryApi) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetScorecardCompassCompassCatalogQueryApi) __premarshalJSON() (*__premarshalGetScorecardCompassCompassCatalogQueryApi, error) {
+	var retval __premarshalGetScorecardCompassCompassCatalogQueryApi
+
+	{
+
+		dst := &retval.Scorecard
+		src := v.Scorecard
+		var err error
+		*dst, err = __marshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetScorecardCompassCompassCatalogQueryApi.Scorecard: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard includes the requested fields of the GraphQL type CompassScorecard.
+type GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard struct {
+	Typename         string                     `json:"__typename"`
+	Id               string                     `json:"id"`
+	Name             string                     `json:"name"`
+	Description      string                     `json:"description"`
+	Importance       CompassScorecardImportance `json:"importance"`
+	ComponentTypeIds []string                   `json:"componentTypeIds"`
+	Owner            ScorecardOwner             `json:"-"`
+	Criterias        []ScorecardCriterion       `json:"criterias"`
+}
+
+// GetTypename returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Typename, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Id, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Name, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Description, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetDescription() string {
+	return v.Description
+}
+
+// GetImportance returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Importance, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetImportance() CompassScorecardImportance {
+	return v.Importance
+}
+
+// GetComponentTypeIds returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.ComponentTypeIds, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetComponentTypeIds() []string {
+	return v.ComponentTypeIds
+}
+
+// GetOwner returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Owner, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetOwner() ScorecardOwner {
+	return v.Owner
+}
+
+// GetCriterias returns GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Criterias, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) GetCriterias() []ScorecardCriterion {
+	return v.Criterias
+}
+
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard
+		Owner json.RawMessage `json:"owner"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Owner
+		src := firstPass.Owner
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalScorecardOwner(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Owner: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Importance CompassScorecardImportance `json:"importance"`
+
+	ComponentTypeIds []string `json:"componentTypeIds"`
+
+	Owner json.RawMessage `json:"owner"`
+
+	Criterias []ScorecardCriterion `json:"criterias"`
+}
+
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) __premarshalJSON() (*__premarshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard, error) {
+	var retval __premarshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	retval.Importance = v.Importance
+	retval.ComponentTypeIds = v.ComponentTypeIds
+	{
+
+		dst := &retval.Owner
+		src := v.Owner
+		var err error
+		*dst, err = __marshalScorecardOwner(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard.Owner: %w", err)
+		}
+	}
+	retval.Criterias = v.Criterias
+	return &retval, nil
+}
+
+// GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult includes the requested fields of the GraphQL interface CompassScorecardResult.
+//
+// GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult is implemented by the following types:
+// GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard
+// GetScorecardCompassCompassCatalogQueryApiScorecardQueryError
+type GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult interface {
+	implementsGraphQLInterfaceGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard) implementsGraphQLInterfaceGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult() {
+}
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardQueryError) implementsGraphQLInterfaceGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult() {
+}
+
+func __unmarshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult(b []byte, v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassScorecard":
+		*v = new(GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(GetScorecardCompassCompassCatalogQueryApiScorecardQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassScorecardResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult(v *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard:
+		typename = "CompassScorecard"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetScorecardCompassCompassCatalogQueryApiScorecardQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetScorecardCompassCompassCatalogQueryApiScorecardQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecardResult: "%T"`, v)
+	}
+}
+
+// GetScorecardCompassCompassCatalogQueryApiScorecardQueryError includes the requested fields of the GraphQL type QueryError.
+type GetScorecardCompassCompassCatalogQueryApiScorecardQueryError struct {
+	Typename   string           `json:"__typename"`
+	Message    string           `json:"message"`
+	Extensions []ErrorExtension `json:"extensions"`
+}
+
+// GetTypename returns GetScorecardCompassCompassCatalogQueryApiScorecardQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardQueryError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns GetScorecardCompassCompassCatalogQueryApiScorecardQueryError.Message, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardQueryError) GetMessage() string {
+	return v.Message
+}
+
+// GetExtensions returns GetScorecardCompassCompassCatalogQueryApiScorecardQueryError.Extensions, and is useful for accessing the field via an interface.
+func (v *GetScorecardCompassCompassCatalogQueryApiScorecardQueryError) GetExtensions() []ErrorExtension {
+	return v.Extensions
+}
+
+// GetScorecardResponse is returned by GetScorecard on success.
+type GetScorecardResponse struct {
+	Compass GetScorecardCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns GetScorecardResponse.Compass, and is useful for accessing the field via an interface.
+func (v *GetScorecardResponse) GetCompass() GetScorecardCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// GetTenantContextsResponse is returned by GetTenantContexts on success.
+type GetTenantContextsResponse struct {
+	TenantContexts []GetTenantContextsTenantContextsTenantContext `json:"tenantContexts"`
+}
+
+// GetTenantContexts returns GetTenantContextsResponse.TenantContexts, and is useful for accessing the field via an interface.
+func (v *GetTenantContextsResponse) GetTenantContexts() []GetTenantContextsTenantContextsTenantContext {
+	return v.TenantContexts
+}
+
+// GetTenantContextsTenantContextsTenantContext includes the requested fields of the GraphQL type TenantContext.
+type GetTenantContextsTenantContextsTenantContext struct {
+	CloudId string `json:"cloudId"`
+}
+
+// GetCloudId returns GetTenantContextsTenantContextsTenantContext.CloudId, and is useful for accessing the field via an interface.
+func (v *GetTenantContextsTenantContextsTenantContext) GetCloudId() string { return v.CloudId }
+
+// MutationErrorFields includes the GraphQL fields of MutationError requested by the fragment MutationErrorFields.
+type MutationErrorFields struct {
+	Message    string         `json:"message"`
+	Extensions ErrorExtension `json:"extensions"`
+}
+
+// GetMessage returns MutationErrorFields.Message, and is useful for accessing the field via an interface.
+func (v *MutationErrorFields) GetMessage() string { return v.Message }
+
+// GetExtensions returns MutationErrorFields.Extensions, and is useful for accessing the field via an interface.
+func (v *MutationErrorFields) GetExtensions() ErrorExtension { return v.Extensions }
+
+type RemoveCompassComponentLabelsInput struct {
+	ComponentId string   `json:"componentId"`
+	LabelNames  []string `json:"labelNames"`
+}
+
+// GetComponentId returns RemoveCompassComponentLabelsInput.ComponentId, and is useful for accessing the field via an interface.
+func (v *RemoveCompassComponentLabelsInput) GetComponentId() string { return v.ComponentId }
+
+// GetLabelNames returns RemoveCompassComponentLabelsInput.LabelNames, and is useful for accessing the field via an interface.
+func (v *RemoveCompassComponentLabelsInput) GetLabelNames() []string { return v.LabelNames }
+
+// RemoveComponentLabelsCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type RemoveComponentLabelsCompassCompassCatalogMutationApi struct {
+	RemoveComponentLabels RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload `json:"removeComponentLabels"`
+}
+
+// GetRemoveComponentLabels returns RemoveComponentLabelsCompassCompassCatalogMutationApi.RemoveComponentLabels, and is useful for accessing the field via an interface.
+func (v *RemoveComponentLabelsCompassCompassCatalogMutationApi) GetRemoveComponentLabels() RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload {
+	return v.RemoveComponentLabels
+}
+
+// RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload includes the requested fields of the GraphQL type RemoveCompassComponentLabelsPayload.
+type RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload.Success, and is useful for accessing the field via an interface.
+func (v *RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload.Errors, and is useful for accessing the field via an interface.
+func (v *RemoveComponentLabelsCompassCompassCatalogMutationApiRemoveComponentLabelsRemoveCompassComponentLabelsPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// RemoveComponentLabelsResponse is returned by RemoveComponentLabels on success.
+type RemoveComponentLabelsResponse struct {
+	Compass RemoveComponentLabelsCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns RemoveComponentLabelsResponse.Compass, and is useful for accessing the field via an interface.
+func (v *RemoveComponentLabelsResponse) GetCompass() RemoveComponentLabelsCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// ScorecardOwner includes the requested fields of the GraphQL interface User.
+//
+// ScorecardOwner is implemented by the following types:
+// ScorecardOwnerAppUser
+// ScorecardOwnerAtlassianAccountUser
+// ScorecardOwnerCustomerUser
+type ScorecardOwner interface {
+	implementsGraphQLInterfaceScorecardOwner()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetAccountId returns the interface-field "accountId" from its implementation.
+	GetAccountId() string
+}
+
+func (v *ScorecardOwnerAppUser) implementsGraphQLInterfaceScorecardOwner()              {}
+func (v *ScorecardOwnerAtlassianAccountUser) implementsGraphQLInterfaceScorecardOwner() {}
+func (v *ScorecardOwnerCustomerUser) implementsGraphQLInterfaceScorecardOwner()         {}
+
+func __unmarshalScorecardOwner(b []byte, v *ScorecardOwner) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AppUser":
+		*v = new(ScorecardOwnerAppUser)
+		return json.Unmarshal(b, *v)
+	case "AtlassianAccountUser":
+		*v = new(ScorecardOwnerAtlassianAccountUser)
+		return json.Unmarshal(b, *v)
+	case "CustomerUser":
+		*v = new(ScorecardOwnerCustomerUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing User.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ScorecardOwner: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalScorecardOwner(v *ScorecardOwner) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ScorecardOwnerAppUser:
+		typename = "AppUser"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ScorecardOwnerAppUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *ScorecardOwnerAtlassianAccountUser:
+		typename = "AtlassianAccountUser"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ScorecardOwnerAtlassianAccountUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *ScorecardOwnerCustomerUser:
+		typename = "CustomerUser"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ScorecardOwnerCustomerUser
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ScorecardOwner: "%T"`, v)
+	}
+}
+
+// ScorecardOwnerAppUser includes the requested fields of the GraphQL type AppUser.
+type ScorecardOwnerAppUser struct {
+	Typename  string `json:"__typename"`
+	AccountId string `json:"accountId"`
+}
+
+// GetTypename returns ScorecardOwnerAppUser.Typename, and is useful for accessing the field via an interface.
+func (v *ScorecardOwnerAppUser) GetTypename() string { return v.Typename }
+
+// GetAccountId returns ScorecardOwnerAppUser.AccountId, and is useful for accessing the field via an interface.
+func (v *ScorecardOwnerAppUser) GetAccountId() string { return v.AccountId }
+
+// ScorecardOwnerAtlassianAccountUser includes the requested fields of the GraphQL type AtlassianAccountUser.
+type ScorecardOwnerAtlassianAccountUser struct {
+	Typename  string `json:"__typename"`
+	AccountId string `json:"accountId"`
+}
+
+// GetTypename returns ScorecardOwnerAtlassianAccountUser.Typename, and is useful for accessing the field via an interface.
+func (v *ScorecardOwnerAtlassianAccountUser) GetTypename() string { return v.Typename }
+
+// GetAccountId returns ScorecardOwnerAtlassianAccountUser.AccountId, and is useful for accessing the field via an interface.
+func (v *ScorecardOwnerAtlassianAccountUser) GetAccountId() string { return v.AccountId }
+
+// ScorecardOwnerCustomerUser includes the requested fields of the GraphQL type CustomerUser.
+type ScorecardOwnerCustomerUser struct {
+	Typename  string `json:"__typename"`
+	AccountId string `json:"accountId"`
+}
+
+// GetTypename returns ScorecardOwnerCustomerUser.Typename, and is useful for accessing the field via an interface.
+func (v *ScorecardOwnerCustomerUser) GetTypename() string { return v.Typename }
+
+// GetAccountId returns ScorecardOwnerCustomerUser.AccountId, and is useful for accessing the field via an interface.
+func (v *ScorecardOwnerCustomerUser) GetAccountId() string { return v.AccountId }
+
+// SearchComponentsCompassCompassCatalogQueryApi includes the requested fields of the GraphQL type CompassCatalogQueryApi.
+type SearchComponentsCompassCompassCatalogQueryApi struct {
+	SearchComponents SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult `json:"-"`
+}
+
+// GetSearchComponents returns SearchComponentsCompassCompassCatalogQueryApi.SearchComponents, and is useful for accessing the field via an interface.
+func (v *SearchComponentsCompassCompassCatalogQueryApi) GetSearchComponents() SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult {
+	return v.SearchComponents
+}
+
+func (v *SearchComponentsCompassCompassCatalogQueryApi) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchComponentsCompassCompassCatalogQueryApi
+		SearchComponents json.RawMessage `json:"searchComponents"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchComponentsCompassCompassCatalogQueryApi = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SearchComponents
+		src := firstPass.SearchComponents
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalSearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal SearchComponentsCompassCompassCatalogQueryApi.SearchComponents: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalSearchComponentsCompassCompassCatalogQueryApi struct {
+	SearchComponents json.RawMessage `json:"searchComponents"`
+}
+
+func (v *SearchComponentsCompassCompassCatalogQueryApi) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchComponentsCompassCompassCatalogQueryApi) __premarshalJSON() (*__premarshalSearchComponentsCompassCompassCatalogQueryApi, error) {
+	var retval __premarshalSearchComponentsCompassCompassCatalogQueryApi
+
+	{
+
+		dst := &retval.SearchComponents
+		src := v.SearchComponents
+		var err error
+		*dst, err = __marshalSearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal SearchComponentsCompassCompassCatalogQueryApi.SearchComponents: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult includes the requested fields of the GraphQL interface CompassComponentQueryResult.
+//
+// SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult is implemented by the following types:
+// SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection
+// SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError
+type SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult interface {
+	implementsGraphQLInterfaceSearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection) implementsGraphQLInterfaceSearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult() {
+}
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError) implementsGraphQLInterfaceSearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult() {
+}
+
+func __unmarshalSearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult(b []byte, v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompassSearchComponentConnection":
+		*v = new(SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection)
+		return json.Unmarshal(b, *v)
+	case "QueryError":
+		*v = new(SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CompassComponentQueryResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalSearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult(v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection:
+		typename = "CompassSearchComponentConnection"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection
+		}{typename, v}
+		return json.Marshal(result)
+	case *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError:
+		typename = "QueryError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassComponentQueryResult: "%T"`, v)
+	}
+}
+
+// SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection includes the requested fields of the GraphQL type CompassSearchComponentConnection.
+type SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection struct {
+	Typename string                                                                                                                           `json:"__typename"`
+	Nodes    []SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnectionNodesCompassSearchComponentResult `json:"nodes"`
+	PageInfo PageInfo                                                                                                                         `json:"pageInfo"`
+}
+
+// GetTypename returns SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection.Typename, and is useful for accessing the field via an interface.
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection) GetTypename() string {
+	return v.Typename
+}
+
+// GetNodes returns SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection) GetNodes() []SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnectionNodesCompassSearchComponentResult {
+	return v.Nodes
+}
+
+// GetPageInfo returns SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection) GetPageInfo() PageInfo {
+	return v.PageInfo
+}
+
+// SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnectionNodesCompassSearchComponentResult includes the requested fields of the GraphQL type CompassSearchComponentResult.
+type SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnectionNodesCompassSearchComponentResult struct {
+	Component SearchedComponent `json:"component"`
+}
+
+// GetComponent returns SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnectionNodesCompassSearchComponentResult.Component, and is useful for accessing the field via an interface.
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnectionNodesCompassSearchComponentResult) GetComponent() SearchedComponent {
+	return v.Component
+}
+
+// SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError includes the requested fields of the GraphQL type QueryError.
+type SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError.Typename, and is useful for accessing the field via an interface.
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError.Message, and is useful for accessing the field via an interface.
+func (v *SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError) GetMessage() string {
+	return v.Message
+}
+
+// SearchComponentsResponse is returned by SearchComponents on success.
+type SearchComponentsResponse struct {
+	Compass SearchComponentsCompassCompassCatalogQueryApi `json:"compass"`
+}
+
+// GetCompass returns SearchComponentsResponse.Compass, and is useful for accessing the field via an interface.
+func (v *SearchComponentsResponse) GetCompass() SearchComponentsCompassCompassCatalogQueryApi {
+	return v.Compass
+}
+
+// SearchTeamsResponse is returned by SearchTeams on success.
+type SearchTeamsResponse struct {
+	Team SearchTeamsTeamTeamQuery `json:"team"`
+}
+
+// GetTeam returns SearchTeamsResponse.Team, and is useful for accessing the field via an interface.
+func (v *SearchTeamsResponse) GetTeam() SearchTeamsTeamTeamQuery { return v.Team }
+
+// SearchTeamsTeamTeamQuery includes the requested fields of the GraphQL type TeamQuery.
+type SearchTeamsTeamTeamQuery struct {
+	TeamSearchV2 SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2 `json:"teamSearchV2"`
+}
+
+// GetTeamSearchV2 returns SearchTeamsTeamTeamQuery.TeamSearchV2, and is useful for accessing the field via an interface.
+func (v *SearchTeamsTeamTeamQuery) GetTeamSearchV2() SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2 {
+	return v.TeamSearchV2
+}
+
+// SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2 includes the requested fields of the GraphQL type TeamSearchResultConnectionV2.
+type SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2 struct {
+	Nodes    []TeamSearchResult `json:"nodes"`
+	PageInfo PageInfo           `json:"pageInfo"`
+}
+
+// GetNodes returns SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2.Nodes, and is useful for accessing the field via an interface.
+func (v *SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2) GetNodes() []TeamSearchResult {
+	return v.Nodes
+}
+
+// GetPageInfo returns SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2.PageInfo, and is useful for accessing the field via an interface.
+func (v *SearchTeamsTeamTeamQueryTeamSearchV2TeamSearchResultConnectionV2) GetPageInfo() PageInfo {
+	return v.PageInfo
+}
+
+// SearchedComponent includes the requested fields of the GraphQL type CompassComponent.
+type SearchedComponent struct {
+	Id          string                                         `json:"id"`
+	Name        string                                         `json:"name"`
+	Slug        string                                         `json:"slug"`
+	Description string                                         `json:"description"`
+	TypeId      string                                         `json:"typeId"`
+	OwnerId     string                                         `json:"ownerId"`
+	Labels      []SearchedComponentLabelsCompassComponentLabel `json:"labels"`
+}
+
+// GetId returns SearchedComponent.Id, and is useful for accessing the field via an interface.
+func (v *SearchedComponent) GetId() string { return v.Id }
+
+// GetName returns SearchedComponent.Name, and is useful for accessing the field via an interface.
+func (v *SearchedComponent) GetName() string { return v.Name }
+
+// GetSlug returns SearchedComponent.Slug, and is useful for accessing the field via an interface.
+func (v *SearchedComponent) GetSlug() string { return v.Slug }
+
+// GetDescription returns SearchedComponent.Description, and is useful for accessing the field via an interface.
+func (v *SearchedComponent) GetDescription() string { return v.Description }
+
+// GetTypeId returns SearchedComponent.TypeId, and is useful for accessing the field via an interface.
+func (v *SearchedComponent) GetTypeId() string { return v.TypeId }
+
+// GetOwnerId returns SearchedComponent.OwnerId, and is useful for accessing the field via an interface.
+func (v *SearchedComponent) GetOwnerId() string { return v.OwnerId }
+
+// GetLabels returns SearchedComponent.Labels, and is useful for accessing the field via an interface.
+func (v *SearchedComponent) GetLabels() []SearchedComponentLabelsCompassComponentLabel {
+	return v.Labels
+}
+
+// SearchedComponentLabelsCompassComponentLabel includes the requested fields of the GraphQL type CompassComponentLabel.
+type SearchedComponentLabelsCompassComponentLabel struct {
+	Name string `json:"name"`
+}
+
+// GetName returns SearchedComponentLabelsCompassComponentLabel.Name, and is useful for accessing the field via an interface.
+func (v *SearchedComponentLabelsCompassComponentLabel) GetName() string { return v.Name }
+
+// Team includes the requested fields of the GraphQL type TeamV2.
+type Team struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+// GetId returns Team.Id, and is useful for accessing the field via an interface.
+func (v *Team) GetId() string { return v.Id }
+
+// GetDisplayName returns Team.DisplayName, and is useful for accessing the field via an interface.
+func (v *Team) GetDisplayName() string { return v.DisplayName }
+
+// GetDescription returns Team.Description, and is useful for accessing the field via an interface.
+func (v *Team) GetDescription() string { return v.Description }
+
+// TeamSearchResult includes the requested fields of the GraphQL type TeamSearchResultV2.
+type TeamSearchResult struct {
+	Team Team `json:"team"`
+}
+
+// GetTeam returns TeamSearchResult.Team, and is useful for accessing the field via an interface.
+func (v *TeamSearchResult) GetTeam() Team { return v.Team }
+
+type UpdateCompassComponentInput struct {
+	Id           string                   `json:"id"`
+	Name         *string                  `json:"name,omitempty"`
+	Description  *string                  `json:"description,omitempty"`
+	OwnerId      *string                  `json:"ownerId"`
+	CustomFields []map[string]interface{} `json:"customFields,omitempty"`
+}
+
+// GetId returns UpdateCompassComponentInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentInput) GetId() string { return v.Id }
+
+// GetName returns UpdateCompassComponentInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentInput) GetName() *string { return v.Name }
+
+// GetDescription returns UpdateCompassComponentInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentInput) GetDescription() *string { return v.Description }
+
+// GetOwnerId returns UpdateCompassComponentInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetCustomFields returns UpdateCompassComponentInput.CustomFields, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentInput) GetCustomFields() []map[string]interface{} {
+	return v.CustomFields
+}
+
+type UpdateCompassComponentLinkInput struct {
+	ComponentId string                 `json:"componentId"`
+	Link        UpdateCompassLinkInput `json:"link"`
+}
+
+// GetComponentId returns UpdateCompassComponentLinkInput.ComponentId, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentLinkInput) GetComponentId() string { return v.ComponentId }
+
+// GetLink returns UpdateCompassComponentLinkInput.Link, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentLinkInput) GetLink() UpdateCompassLinkInput { return v.Link }
+
+type UpdateCompassComponentTypeInput struct {
+	Id     string               `json:"id"`
+	Type   CompassComponentType `json:"type,omitempty"`
+	TypeId string               `json:"typeId,omitempty"`
+}
+
+// GetId returns UpdateCompassComponentTypeInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentTypeInput) GetId() string { return v.Id }
+
+// GetType returns UpdateCompassComponentTypeInput.Type, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentTypeInput) GetType() CompassComponentType { return v.Type }
+
+// GetTypeId returns UpdateCompassComponentTypeInput.TypeId, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentTypeInput) GetTypeId() string { return v.TypeId }
+
+type UpdateCompassComponentTypeMetadataInput struct {
+	Id          string  `json:"id"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IconKey     *string `json:"iconKey,omitempty"`
+}
+
+// GetId returns UpdateCompassComponentTypeMetadataInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentTypeMetadataInput) GetId() string { return v.Id }
+
+// GetName returns UpdateCompassComponentTypeMetadataInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentTypeMetadataInput) GetName() *string { return v.Name }
+
+// GetDescription returns UpdateCompassComponentTypeMetadataInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentTypeMetadataInput) GetDescription() *string { return v.Description }
+
+// GetIconKey returns UpdateCompassComponentTypeMetadataInput.IconKey, and is useful for accessing the field via an interface.
+func (v *UpdateCompassComponentTypeMetadataInput) GetIconKey() *string { return v.IconKey }
+
+type UpdateCompassLinkInput struct {
+	Id       string           `json:"id"`
+	Name     *string          `json:"name,omitempty"`
+	Type     *CompassLinkType `json:"type,omitempty"`
+	Url      *string          `json:"url,omitempty"`
+	ObjectId *string          `json:"objectId"`
+}
+
+// GetId returns UpdateCompassLinkInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateCompassLinkInput) GetId() string { return v.Id }
+
+// GetName returns UpdateCompassLinkInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateCompassLinkInput) GetName() *string { return v.Name }
+
+// GetType returns UpdateCompassLinkInput.Type, and is useful for accessing the field via an interface.
+func (v *UpdateCompassLinkInput) GetType() *CompassLinkType { return v.Type }
+
+// GetUrl returns UpdateCompassLinkInput.Url, and is useful for accessing the field via an interface.
+func (v *UpdateCompassLinkInput) GetUrl() *string { return v.Url }
+
+// GetObjectId returns UpdateCompassLinkInput.ObjectId, and is useful for accessing the field via an interface.
+func (v *UpdateCompassLinkInput) GetObjectId() *string { return v.ObjectId }
+
+type UpdateCompassScorecardInput struct {
+	Name             *string                               `json:"name,omitempty"`
+	Description      *string                               `json:"description,omitempty"`
+	OwnerId          *string                               `json:"ownerId"`
+	Importance       CompassScorecardImportance            `json:"importance,omitempty"`
+	ComponentTypeIds []string                              `json:"componentTypeIds,omitempty"`
+	CreateCriteria   []map[string]interface{}              `json:"createCriteria,omitempty"`
+	UpdateCriteria   []map[string]interface{}              `json:"updateCriteria,omitempty"`
+	DeleteCriteria   []DeleteCompassScorecardCriteriaInput `json:"deleteCriteria,omitempty"`
+}
+
+// GetName returns UpdateCompassScorecardInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetName() *string { return v.Name }
+
+// GetDescription returns UpdateCompassScorecardInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetDescription() *string { return v.Description }
+
+// GetOwnerId returns UpdateCompassScorecardInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetImportance returns UpdateCompassScorecardInput.Importance, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetImportance() CompassScorecardImportance { return v.Importance }
+
+// GetComponentTypeIds returns UpdateCompassScorecardInput.ComponentTypeIds, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetComponentTypeIds() []string { return v.ComponentTypeIds }
+
+// GetCreateCriteria returns UpdateCompassScorecardInput.CreateCriteria, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetCreateCriteria() []map[string]interface{} {
+	return v.CreateCriteria
+}
+
+// GetUpdateCriteria returns UpdateCompassScorecardInput.UpdateCriteria, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetUpdateCriteria() []map[string]interface{} {
+	return v.UpdateCriteria
+}
+
+// GetDeleteCriteria returns UpdateCompassScorecardInput.DeleteCriteria, and is useful for accessing the field via an interface.
+func (v *UpdateCompassScorecardInput) GetDeleteCriteria() []DeleteCompassScorecardCriteriaInput {
+	return v.DeleteCriteria
+}
+
+// UpdateComponentCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type UpdateComponentCompassCompassCatalogMutationApi struct {
+	UpdateComponent UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload `json:"updateComponent"`
+}
+
+// GetUpdateComponent returns UpdateComponentCompassCompassCatalogMutationApi.UpdateComponent, and is useful for accessing the field via an interface.
+func (v *UpdateComponentCompassCompassCatalogMutationApi) GetUpdateComponent() UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload {
+	return v.UpdateComponent
+}
+
+// UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload includes the requested fields of the GraphQL type UpdateCompassComponentPayload.
+type UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload.Success, and is useful for accessing the field via an interface.
+func (v *UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload.Errors, and is useful for accessing the field via an interface.
+func (v *UpdateComponentCompassCompassCatalogMutationApiUpdateComponentUpdateCompassComponentPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// UpdateComponentLinkCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type UpdateComponentLinkCompassCompassCatalogMutationApi struct {
+	UpdateComponentLink UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload `json:"updateComponentLink"`
+}
+
+// GetUpdateComponentLink returns UpdateComponentLinkCompassCompassCatalogMutationApi.UpdateComponentLink, and is useful for accessing the field via an interface.
+func (v *UpdateComponentLinkCompassCompassCatalogMutationApi) GetUpdateComponentLink() UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload {
+	return v.UpdateComponentLink
+}
+
+// UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload includes the requested fields of the GraphQL type UpdateCompassComponentLinkPayload.
+type UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload.Success, and is useful for accessing the field via an interface.
+func (v *UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload.Errors, and is useful for accessing the field via an interface.
+func (v *UpdateComponentLinkCompassCompassCatalogMutationApiUpdateComponentLinkUpdateCompassComponentLinkPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// UpdateComponentLinkResponse is returned by UpdateComponentLink on success.
+type UpdateComponentLinkResponse struct {
+	Compass UpdateComponentLinkCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns UpdateComponentLinkResponse.Compass, and is useful for accessing the field via an interface.
+func (v *UpdateComponentLinkResponse) GetCompass() UpdateComponentLinkCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// UpdateComponentResponse is returned by UpdateComponent on success.
+type UpdateComponentResponse struct {
+	Compass UpdateComponentCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns UpdateComponentResponse.Compass, and is useful for accessing the field via an interface.
+func (v *UpdateComponentResponse) GetCompass() UpdateComponentCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// UpdateComponentTypeCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type UpdateComponentTypeCompassCompassCatalogMutationApi struct {
+	UpdateComponentType UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload `json:"updateComponentType"`
+}
+
+// GetUpdateComponentType returns UpdateComponentTypeCompassCompassCatalogMutationApi.UpdateComponentType, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeCompassCompassCatalogMutationApi) GetUpdateComponentType() UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload {
+	return v.UpdateComponentType
+}
+
+// UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload includes the requested fields of the GraphQL type UpdateCompassComponentTypePayload.
+type UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload.Success, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload.Errors, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeCompassCompassCatalogMutationApiUpdateComponentTypeUpdateCompassComponentTypePayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// UpdateComponentTypeMetadataCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type UpdateComponentTypeMetadataCompassCompassCatalogMutationApi struct {
+	UpdateComponentTypeMetadata UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload `json:"updateComponentTypeMetadata"`
+}
+
+// GetUpdateComponentTypeMetadata returns UpdateComponentTypeMetadataCompassCompassCatalogMutationApi.UpdateComponentTypeMetadata, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeMetadataCompassCompassCatalogMutationApi) GetUpdateComponentTypeMetadata() UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload {
+	return v.UpdateComponentTypeMetadata
+}
+
+// UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload includes the requested fields of the GraphQL type UpdateCompassComponentTypeMetadataPayload.
+type UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload.Success, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload.Errors, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeMetadataCompassCompassCatalogMutationApiUpdateComponentTypeMetadataUpdateCompassComponentTypeMetadataPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// UpdateComponentTypeMetadataResponse is returned by UpdateComponentTypeMetadata on success.
+type UpdateComponentTypeMetadataResponse struct {
+	Compass UpdateComponentTypeMetadataCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns UpdateComponentTypeMetadataResponse.Compass, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeMetadataResponse) GetCompass() UpdateComponentTypeMetadataCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// UpdateComponentTypeResponse is returned by UpdateComponentType on success.
+type UpdateComponentTypeResponse struct {
+	Compass UpdateComponentTypeCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns UpdateComponentTypeResponse.Compass, and is useful for accessing the field via an interface.
+func (v *UpdateComponentTypeResponse) GetCompass() UpdateComponentTypeCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// UpdateCustomFieldDefinitionCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type UpdateCustomFieldDefinitionCompassCompassCatalogMutationApi struct {
+	UpdateCustomFieldDefinition UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload `json:"updateCustomFieldDefinition"`
+}
+
+// GetUpdateCustomFieldDefinition returns UpdateCustomFieldDefinitionCompassCompassCatalogMutationApi.UpdateCustomFieldDefinition, and is useful for accessing the field via an interface.
+func (v *UpdateCustomFieldDefinitionCompassCompassCatalogMutationApi) GetUpdateCustomFieldDefinition() UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload {
+	return v.UpdateCustomFieldDefinition
+}
+
+// UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload includes the requested fields of the GraphQL type CompassUpdateCustomFieldDefinitionPayload.
+type UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload.Success, and is useful for accessing the field via an interface.
+func (v *UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload.Errors, and is useful for accessing the field via an interface.
+func (v *UpdateCustomFieldDefinitionCompassCompassCatalogMutationApiUpdateCustomFieldDefinitionCompassUpdateCustomFieldDefinitionPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// UpdateCustomFieldDefinitionResponse is returned by UpdateCustomFieldDefinition on success.
+type UpdateCustomFieldDefinitionResponse struct {
+	Compass UpdateCustomFieldDefinitionCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns UpdateCustomFieldDefinitionResponse.Compass, and is useful for accessing the field via an interface.
+func (v *UpdateCustomFieldDefinitionResponse) GetCompass() UpdateCustomFieldDefinitionCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// UpdateScorecardCompassCompassCatalogMutationApi includes the requested fields of the GraphQL type CompassCatalogMutationApi.
+type UpdateScorecardCompassCompassCatalogMutationApi struct {
+	UpdateScorecard UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload `json:"updateScorecard"`
+}
+
+// GetUpdateScorecard returns UpdateScorecardCompassCompassCatalogMutationApi.UpdateScorecard, and is useful for accessing the field via an interface.
+func (v *UpdateScorecardCompassCompassCatalogMutationApi) GetUpdateScorecard() UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload {
+	return v.UpdateScorecard
+}
+
+// UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload includes the requested fields of the GraphQL type UpdateCompassScorecardPayload.
+type UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload struct {
+	Success bool                  `json:"success"`
+	Errors  []MutationErrorFields `json:"errors"`
+}
+
+// GetSuccess returns UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload.Success, and is useful for accessing the field via an interface.
+func (v *UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// GetErrors returns UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload.Errors, and is useful for accessing the field via an interface.
+func (v *UpdateScorecardCompassCompassCatalogMutationApiUpdateScorecardUpdateCompassScorecardPayload) GetErrors() []MutationErrorFields {
+	return v.Errors
+}
+
+// UpdateScorecardResponse is returned by UpdateScorecard on success.
+type UpdateScorecardResponse struct {
+	Compass UpdateScorecardCompassCompassCatalogMutationApi `json:"compass"`
+}
+
+// GetCompass returns UpdateScorecardResponse.Compass, and is useful for accessing the field via an interface.
+func (v *UpdateScorecardResponse) GetCompass() UpdateScorecardCompassCompassCatalogMutationApi {
+	return v.Compass
+}
+
+// __AddComponentLabelsInput is used internally by genqlient
+type __AddComponentLabelsInput struct {
+	Input AddCompassComponentLabelsInput `json:"input"`
+}
+
+// GetInput returns __AddComponentLabelsInput.Input, and is useful for accessing the field via an interface.
+func (v *__AddComponentLabelsInput) GetInput() AddCompassComponentLabelsInput { return v.Input }
+
+// __CreateComponentInput is used internally by genqlient
+type __CreateComponentInput struct {
+	CloudId string                      `json:"cloudId"`
+	Input   CreateCompassComponentInput `json:"input"`
+}
+
+// GetCloudId returns __CreateComponentInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__CreateComponentInput) GetCloudId() string { return v.CloudId }
+
+// GetInput returns __CreateComponentInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateComponentInput) GetInput() CreateCompassComponentInput { return v.Input }
+
+// __CreateComponentLinkInput is used internally by genqlient
+type __CreateComponentLinkInput struct {
+	Input CreateCompassComponentLinkInput `json:"input"`
+}
+
+// GetInput returns __CreateComponentLinkInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateComponentLinkInput) GetInput() CreateCompassComponentLinkInput { return v.Input }
+
+// __CreateComponentTypeInput is used internally by genqlient
+type __CreateComponentTypeInput struct {
+	CloudId string                          `json:"cloudId"`
+	Input   CreateCompassComponentTypeInput `json:"input"`
+}
+
+// GetCloudId returns __CreateComponentTypeInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__CreateComponentTypeInput) GetCloudId() string { return v.CloudId }
+
+// GetInput returns __CreateComponentTypeInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateComponentTypeInput) GetInput() CreateCompassComponentTypeInput { return v.Input }
+
+// __CreateCustomFieldDefinitionInput is used internally by genqlient
+type __CreateCustomFieldDefinitionInput struct {
+	Input map[string]interface{} `json:"input"`
+}
+
+// GetInput returns __CreateCustomFieldDefinitionInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateCustomFieldDefinitionInput) GetInput() map[string]interface{} { return v.Input }
+
+// __CreateRelationshipInput is used internally by genqlient
+type __CreateRelationshipInput struct {
+	Input CreateCompassRelationshipInput `json:"input"`
+}
+
+// GetInput returns __CreateRelationshipInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateRelationshipInput) GetInput() CreateCompassRelationshipInput { return v.Input }
+
+// __CreateScorecardInput is used internally by genqlient
+type __CreateScorecardInput struct {
+	CloudId string                      `json:"cloudId"`
+	Input   CreateCompassScorecardInput `json:"input"`
+}
+
+// GetCloudId returns __CreateScorecardInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__CreateScorecardInput) GetCloudId() string { return v.CloudId }
+
+// GetInput returns __CreateScorecardInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateScorecardInput) GetInput() CreateCompassScorecardInput { return v.Input }
+
+// __DeleteComponentInput is used internally by genqlient
+type __DeleteComponentInput struct {
+	Input DeleteCompassComponentInput `json:"input"`
+}
+
+// GetInput returns __DeleteComponentInput.Input, and is useful for accessing the field via an interface.
+func (v *__DeleteComponentInput) GetInput() DeleteCompassComponentInput { return v.Input }
+
+// __DeleteComponentLinkInput is used internally by genqlient
+type __DeleteComponentLinkInput struct {
+	Input DeleteCompassComponentLinkInput `json:"input"`
+}
+
+// GetInput returns __DeleteComponentLinkInput.Input, and is useful for accessing the field via an interface.
+func (v *__DeleteComponentLinkInput) GetInput() DeleteCompassComponentLinkInput { return v.Input }
+
+// __DeleteComponentTypeInput is used internally by genqlient
+type __DeleteComponentTypeInput struct {
+	Input DeleteCompassComponentTypeInput `json:"input"`
+}
+
+// GetInput returns __DeleteComponentTypeInput.Input, and is useful for accessing the field via an interface.
+func (v *__DeleteComponentTypeInput) GetInput() DeleteCompassComponentTypeInput { return v.Input }
+
+// __DeleteCustomFieldDefinitionInput is used internally by genqlient
+type __DeleteCustomFieldDefinitionInput struct {
+	Input CompassDeleteCustomFieldDefinitionInput `json:"input"`
+}
+
+// GetInput returns __DeleteCustomFieldDefinitionInput.Input, and is useful for accessing the field via an interface.
+func (v *__DeleteCustomFieldDefinitionInput) GetInput() CompassDeleteCustomFieldDefinitionInput {
+	return v.Input
+}
+
+// __DeleteRelationshipInput is used internally by genqlient
+type __DeleteRelationshipInput struct {
+	Input DeleteCompassRelationshipInput `json:"input"`
+}
+
+// GetInput returns __DeleteRelationshipInput.Input, and is useful for accessing the field via an interface.
+func (v *__DeleteRelationshipInput) GetInput() DeleteCompassRelationshipInput { return v.Input }
+
+// __DeleteScorecardInput is used internally by genqlient
+type __DeleteScorecardInput struct {
+	ScorecardId string `json:"scorecardId"`
+}
+
+// GetScorecardId returns __DeleteScorecardInput.ScorecardId, and is useful for accessing the field via an interface.
+func (v *__DeleteScorecardInput) GetScorecardId() string { return v.ScorecardId }
+
+// __GetComponentInput is used internally by genqlient
+type __GetComponentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetComponentInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetComponentInput) GetId() string { return v.Id }
+
+// __GetComponentLinksInput is used internally by genqlient
+type __GetComponentLinksInput struct {
+	ComponentId string `json:"componentId"`
+}
+
+// GetComponentId returns __GetComponentLinksInput.ComponentId, and is useful for accessing the field via an interface.
+func (v *__GetComponentLinksInput) GetComponentId() string { return v.ComponentId }
+
+// __GetComponentRelationshipsInput is used internally by genqlient
+type __GetComponentRelationshipsInput struct {
+	Id    string                   `json:"id"`
+	Query CompassRelationshipQuery `json:"query"`
+}
+
+// GetId returns __GetComponentRelationshipsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetComponentRelationshipsInput) GetId() string { return v.Id }
+
+// GetQuery returns __GetComponentRelationshipsInput.Query, and is useful for accessing the field via an interface.
+func (v *__GetComponentRelationshipsInput) GetQuery() CompassRelationshipQuery { return v.Query }
+
+// __GetComponentTypeInput is used internally by genqlient
+type __GetComponentTypeInput struct {
+	CloudId string `json:"cloudId"`
+	Id      string `json:"id"`
+}
+
+// GetCloudId returns __GetComponentTypeInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__GetComponentTypeInput) GetCloudId() string { return v.CloudId }
+
+// GetId returns __GetComponentTypeInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetComponentTypeInput) GetId() string { return v.Id }
+
+// __GetComponentTypesInput is used internally by genqlient
+type __GetComponentTypesInput struct {
+	CloudId string                         `json:"cloudId"`
+	Query   CompassComponentTypeQueryInput `json:"query"`
+}
+
+// GetCloudId returns __GetComponentTypesInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__GetComponentTypesInput) GetCloudId() string { return v.CloudId }
+
+// GetQuery returns __GetComponentTypesInput.Query, and is useful for accessing the field via an interface.
+func (v *__GetComponentTypesInput) GetQuery() CompassComponentTypeQueryInput { return v.Query }
+
+// __GetCustomFieldDefinitionInput is used internally by genqlient
+type __GetCustomFieldDefinitionInput struct {
+	CloudId string `json:"cloudId"`
+	Id      string `json:"id"`
+}
+
+// GetCloudId returns __GetCustomFieldDefinitionInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__GetCustomFieldDefinitionInput) GetCloudId() string { return v.CloudId }
+
+// GetId returns __GetCustomFieldDefinitionInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetCustomFieldDefinitionInput) GetId() string { return v.Id }
+
+// __GetScorecardInput is used internally by genqlient
+type __GetScorecardInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetScorecardInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetScorecardInput) GetId() string { return v.Id }
+
+// __GetTenantContextsInput is used internally by genqlient
+type __GetTenantContextsInput struct {
+	HostNames []string `json:"hostNames"`
+}
+
+// GetHostNames returns __GetTenantContextsInput.HostNames, and is useful for accessing the field via an interface.
+func (v *__GetTenantContextsInput) GetHostNames() []string { return v.HostNames }
+
+// __RemoveComponentLabelsInput is used internally by genqlient
+type __RemoveComponentLabelsInput struct {
+	Input RemoveCompassComponentLabelsInput `json:"input"`
+}
+
+// GetInput returns __RemoveComponentLabelsInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemoveComponentLabelsInput) GetInput() RemoveCompassComponentLabelsInput { return v.Input }
+
+// __SearchComponentsInput is used internally by genqlient
+type __SearchComponentsInput struct {
+	CloudId string                      `json:"cloudId"`
+	Query   CompassSearchComponentQuery `json:"query"`
+}
+
+// GetCloudId returns __SearchComponentsInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__SearchComponentsInput) GetCloudId() string { return v.CloudId }
+
+// GetQuery returns __SearchComponentsInput.Query, and is useful for accessing the field via an interface.
+func (v *__SearchComponentsInput) GetQuery() CompassSearchComponentQuery { return v.Query }
+
+// __SearchTeamsInput is used internally by genqlient
+type __SearchTeamsInput struct {
+	OrganizationId string `json:"organizationId"`
+	SiteId         string `json:"siteId"`
+	Query          string `json:"query"`
+	First          int    `json:"first"`
+	After          string `json:"after,omitempty"`
+}
+
+// GetOrganizationId returns __SearchTeamsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__SearchTeamsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetSiteId returns __SearchTeamsInput.SiteId, and is useful for accessing the field via an interface.
+func (v *__SearchTeamsInput) GetSiteId() string { return v.SiteId }
+
+// GetQuery returns __SearchTeamsInput.Query, and is useful for accessing the field via an interface.
+func (v *__SearchTeamsInput) GetQuery() string { return v.Query }
+
+// GetFirst returns __SearchTeamsInput.First, and is useful for accessing the field via an interface.
+func (v *__SearchTeamsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __SearchTeamsInput.After, and is useful for accessing the field via an interface.
+func (v *__SearchTeamsInput) GetAfter() string { return v.After }
+
+// __UpdateComponentInput is used internally by genqlient
+type __UpdateComponentInput struct {
+	Input UpdateCompassComponentInput `json:"input"`
+}
+
+// GetInput returns __UpdateComponentInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateComponentInput) GetInput() UpdateCompassComponentInput { return v.Input }
+
+// __UpdateComponentLinkInput is used internally by genqlient
+type __UpdateComponentLinkInput struct {
+	Input UpdateCompassComponentLinkInput `json:"input"`
+}
+
+// GetInput returns __UpdateComponentLinkInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateComponentLinkInput) GetInput() UpdateCompassComponentLinkInput { return v.Input }
+
+// __UpdateComponentTypeInput is used internally by genqlient
+type __UpdateComponentTypeInput struct {
+	Input UpdateCompassComponentTypeInput `json:"input"`
+}
+
+// GetInput returns __UpdateComponentTypeInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateComponentTypeInput) GetInput() UpdateCompassComponentTypeInput { return v.Input }
+
+// __UpdateComponentTypeMetadataInput is used internally by genqlient
+type __UpdateComponentTypeMetadataInput struct {
+	Input UpdateCompassComponentTypeMetadataInput `json:"input"`
+}
+
+// GetInput returns __UpdateComponentTypeMetadataInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateComponentTypeMetadataInput) GetInput() UpdateCompassComponentTypeMetadataInput {
+	return v.Input
+}
+
+// __UpdateCustomFieldDefinitionInput is used internally by genqlient
+type __UpdateCustomFieldDefinitionInput struct {
+	Input map[string]interface{} `json:"input"`
+}
+
+// GetInput returns __UpdateCustomFieldDefinitionInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateCustomFieldDefinitionInput) GetInput() map[string]interface{} { return v.Input }
+
+// __UpdateScorecardInput is used internally by genqlient
+type __UpdateScorecardInput struct {
+	CloudId     string                      `json:"cloudId"`
+	ScorecardId string                      `json:"scorecardId"`
+	Input       UpdateCompassScorecardInput `json:"input"`
+}
+
+// GetCloudId returns __UpdateScorecardInput.CloudId, and is useful for accessing the field via an interface.
+func (v *__UpdateScorecardInput) GetCloudId() string { return v.CloudId }
+
+// GetScorecardId returns __UpdateScorecardInput.ScorecardId, and is useful for accessing the field via an interface.
+func (v *__UpdateScorecardInput) GetScorecardId() string { return v.ScorecardId }
+
+// GetInput returns __UpdateScorecardInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateScorecardInput) GetInput() UpdateCompassScorecardInput { return v.Input }
+
+// The query or mutation executed by AddComponentLabels.
+const AddComponentLabels_Operation = `
+mutation AddComponentLabels ($input: AddCompassComponentLabelsInput!) {
+	compass {
+		addComponentLabels(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func AddComponentLabels(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input AddCompassComponentLabelsInput,
+) (*AddComponentLabelsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "AddComponentLabels",
+		Query:  AddComponentLabels_Operation,
+		Variables: &__AddComponentLabelsInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ AddComponentLabelsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateComponent.
+const CreateComponent_Operation = `
+mutation CreateComponent ($cloudId: ID!, $input: CreateCompassComponentInput!) {
+	compass {
+		createComponent(cloudId: $cloudId, input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+			componentDetails {
+				id
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+// Components are created with their custom fields, labels and links are added afterwards
+func CreateComponent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	input CreateCompassComponentInput,
+) (*CreateComponentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateComponent",
+		Query:  CreateComponent_Operation,
+		Variables: &__CreateComponentInput{
+			CloudId: cloudId,
+			Input:   input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateComponentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateComponentLink.
+const CreateComponentLink_Operation = `
+mutation CreateComponentLink ($input: CreateCompassComponentLinkInput!) {
+	compass {
+		createComponentLink(input: $input) {
+			success
+			createdComponentLink {
+				... ComponentLinkFields
+			}
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment ComponentLinkFields on CompassLink {
+	id
+	name
+	type
+	url
+	objectId
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func CreateComponentLink(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateCompassComponentLinkInput,
+) (*CreateComponentLinkResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateComponentLink",
+		Query:  CreateComponentLink_Operation,
+		Variables: &__CreateComponentLinkInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateComponentLinkResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateComponentType.
+const CreateComponentType_Operation = `
+mutation CreateComponentType ($cloudId: ID!, $input: CreateCompassComponentTypeInput!) {
+	compass {
+		createComponentType(cloudId: $cloudId, input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+			createdComponentType {
+				id
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func CreateComponentType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	input CreateCompassComponentTypeInput,
+) (*CreateComponentTypeResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateComponentType",
+		Query:  CreateComponentType_Operation,
+		Variables: &__CreateComponentTypeInput{
+			CloudId: cloudId,
+			Input:   input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateComponentTypeResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateCustomFieldDefinition.
+const CreateCustomFieldDefinition_Operation = `
+mutation CreateCustomFieldDefinition ($input: CompassCreateCustomFieldDefinitionInput!) {
+	compass {
+		createCustomFieldDefinition(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+			customFieldDefinition {
+				__typename
+				id
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func CreateCustomFieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input map[string]interface{},
+) (*CreateCustomFieldDefinitionResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateCustomFieldDefinition",
+		Query:  CreateCustomFieldDefinition_Operation,
+		Variables: &__CreateCustomFieldDefinitionInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateCustomFieldDefinitionResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateRelationship.
+const CreateRelationship_Operation = `
+mutation CreateRelationship ($input: CreateCompassRelationshipInput!) {
+	compass {
+		createRelationship(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func CreateRelationship(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateCompassRelationshipInput,
+) (*CreateRelationshipResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateRelationship",
+		Query:  CreateRelationship_Operation,
+		Variables: &__CreateRelationshipInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateRelationshipResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateScorecard.
+const CreateScorecard_Operation = `
+mutation CreateScorecard ($cloudId: ID!, $input: CreateCompassScorecardInput!) {
+	compass {
+		createScorecard(cloudId: $cloudId, input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+			scorecardDetails {
+				id
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func CreateScorecard(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	input CreateCompassScorecardInput,
+) (*CreateScorecardResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateScorecard",
+		Query:  CreateScorecard_Operation,
+		Variables: &__CreateScorecardInput{
+			CloudId: cloudId,
+			Input:   input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateScorecardResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DeleteComponent.
+const DeleteComponent_Operation = `
+mutation DeleteComponent ($input: DeleteCompassComponentInput!) {
+	compass {
+		deleteComponent(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func DeleteComponent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input DeleteCompassComponentInput,
+) (*DeleteComponentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteComponent",
+		Query:  DeleteComponent_Operation,
+		Variables: &__DeleteComponentInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteComponentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DeleteComponentLink.
+const DeleteComponentLink_Operation = `
+mutation DeleteComponentLink ($input: DeleteCompassComponentLinkInput!) {
+	compass {
+		deleteComponentLink(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func DeleteComponentLink(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input DeleteCompassComponentLinkInput,
+) (*DeleteComponentLinkResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteComponentLink",
+		Query:  DeleteComponentLink_Operation,
+		Variables: &__DeleteComponentLinkInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteComponentLinkResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DeleteComponentType.
+const DeleteComponentType_Operation = `
+mutation DeleteComponentType ($input: DeleteCompassComponentTypeInput!) {
+	compass {
+		deleteComponentType(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func DeleteComponentType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input DeleteCompassComponentTypeInput,
+) (*DeleteComponentTypeResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteComponentType",
+		Query:  DeleteComponentType_Operation,
+		Variables: &__DeleteComponentTypeInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteComponentTypeResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DeleteCustomFieldDefinition.
+const DeleteCustomFieldDefinition_Operation = `
+mutation DeleteCustomFieldDefinition ($input: CompassDeleteCustomFieldDefinitionInput!) {
+	compass {
+		deleteCustomFieldDefinition(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func DeleteCustomFieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CompassDeleteCustomFieldDefinitionInput,
+) (*DeleteCustomFieldDefinitionResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteCustomFieldDefinition",
+		Query:  DeleteCustomFieldDefinition_Operation,
+		Variables: &__DeleteCustomFieldDefinitionInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteCustomFieldDefinitionResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DeleteRelationship.
+const DeleteRelationship_Operation = `
+mutation DeleteRelationship ($input: DeleteCompassRelationshipInput!) {
+	compass {
+		deleteRelationship(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func DeleteRelationship(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input DeleteCompassRelationshipInput,
+) (*DeleteRelationshipResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteRelationship",
+		Query:  DeleteRelationship_Operation,
+		Variables: &__DeleteRelationshipInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteRelationshipResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DeleteScorecard.
+const DeleteScorecard_Operation = `
+mutation DeleteScorecard ($scorecardId: ID!) {
+	compass {
+		deleteScorecard(scorecardId: $scorecardId) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func DeleteScorecard(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	scorecardId string,
+) (*DeleteScorecardResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteScorecard",
+		Query:  DeleteScorecard_Operation,
+		Variables: &__DeleteScorecardInput{
+			ScorecardId: scorecardId,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteScorecardResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetComponent.
+const GetComponent_Operation = `
+query GetComponent ($id: ID!) {
+	compass {
+		component(id: $id) {
+			__typename
+			... on CompassComponent {
+				id
+				name
+				slug
+				description
+				typeId
+				ownerId
+				labels {
+					name
+				}
+				links {
+					... ComponentLinkFields
+				}
+				customFields {
+					__typename
+					definition {
+						__typename
+						id
+					}
+					... on CompassCustomBooleanField {
+						booleanValue
+					}
+					... on CompassCustomTextField {
+						textValue
+					}
+					... on CompassCustomNumberField {
+						numberValue
+					}
+					... on CompassCustomSingleSelectField {
+						option {
+							id
+						}
+					}
+					... on CompassCustomMultiSelectField {
+						options {
+							id
+						}
+					}
+					... on CompassCustomUserField {
+						userValue {
+							__typename
+							accountId
+						}
+					}
+				}
+			}
+			... on QueryError {
+				message
+				extensions {
+					__typename
+					statusCode
+					errorType
+				}
+			}
+		}
+	}
+}
+fragment ComponentLinkFields on CompassLink {
+	id
+	name
+	type
+	url
+	objectId
+}
+`
+
+func GetComponent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*GetComponentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetComponent",
+		Query:  GetComponent_Operation,
+		Variables: &__GetComponentInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ GetComponentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetComponentLinks.
+const GetComponentLinks_Operation = `
+query GetComponentLinks ($componentId: ID!) {
+	compass {
+		component(id: $componentId) {
+			__typename
+			... on CompassComponent {
+				id
+				links {
+					... ComponentLinkFields
+				}
+			}
+			... on QueryError {
+				message
+				extensions {
+					__typename
+					statusCode
+					errorType
+				}
+			}
+		}
+	}
+}
+fragment ComponentLinkFields on CompassLink {
+	id
+	name
+	type
+	url
+	objectId
+}
+`
+
+// Links can only be read through their component
+func GetComponentLinks(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	componentId string,
+) (*GetComponentLinksResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetComponentLinks",
+		Query:  GetComponentLinks_Operation,
+		Variables: &__GetComponentLinksInput{
+			ComponentId: componentId,
+		},
+	}
+	var err_ error
+
+	var data_ GetComponentLinksResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetComponentRelationships.
+const GetComponentRelationships_Operation = `
+query GetComponentRelationships ($id: ID!, $query: CompassRelationshipQuery!) {
+	compass {
+		component(id: $id) {
+			__typename
+			... on CompassComponent {
+				id
+				relationships(query: $query) {
+					__typename
+					... on CompassRelationshipConnection {
+						nodes {
+							type
+							startNode {
+								__typename
+								... on CompassComponent {
+									id
+								}
+							}
+							endNode {
+								__typename
+								... on CompassComponent {
+									id
+								}
+							}
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+					... on QueryError {
+						message
+					}
+				}
+			}
+			... on QueryError {
+				message
+				extensions {
+					__typename
+					statusCode
+					errorType
+				}
+			}
+		}
+	}
+}
+`
+
+// Relationships have no query of their own, they are listed on their start component
+func GetComponentRelationships(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	query CompassRelationshipQuery,
+) (*GetComponentRelationshipsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetComponentRelationships",
+		Query:  GetComponentRelationships_Operation,
+		Variables: &__GetComponentRelationshipsInput{
+			Id:    id,
+			Query: query,
+		},
+	}
+	var err_ error
+
+	var data_ GetComponentRelationshipsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetComponentType.
+const GetComponentType_Operation = `
+query GetComponentType ($cloudId: ID!, $id: ID!) {
+	compass {
+		componentType(cloudId: $cloudId, id: $id) {
+			__typename
+			... on CompassComponentTypeObject {
+				... ComponentTypeFields
+			}
+			... on QueryError {
+				message
+				extensions {
+					__typename
+					statusCode
+					errorType
+				}
+			}
+		}
+	}
+}
+fragment ComponentTypeFields on CompassComponentTypeObject {
+	id
+	name
+	description
+	iconUrl
+}
+`
+
+func GetComponentType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	id string,
+) (*GetComponentTypeResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetComponentType",
+		Query:  GetComponentType_Operation,
+		Variables: &__GetComponentTypeInput{
+			CloudId: cloudId,
+			Id:      id,
+		},
+	}
+	var err_ error
+
+	var data_ GetComponentTypeResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetComponentTypes.
+const GetComponentTypes_Operation = `
+query GetComponentTypes ($cloudId: ID!, $query: CompassComponentTypeQueryInput!) {
+	compass {
+		componentTypes(cloudId: $cloudId, query: $query) {
+			__typename
+			... on CompassComponentTypeConnection {
+				nodes {
+					... ComponentTypeFields
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+			... on QueryError {
+				message
+			}
+		}
+	}
+}
+fragment ComponentTypeFields on CompassComponentTypeObject {
+	id
+	name
+	description
+	iconUrl
+}
+`
+
+// Lists the built-in and custom component types of a site
+func GetComponentTypes(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	query CompassComponentTypeQueryInput,
+) (*GetComponentTypesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetComponentTypes",
+		Query:  GetComponentTypes_Operation,
+		Variables: &__GetComponentTypesInput{
+			CloudId: cloudId,
+			Query:   query,
+		},
+	}
+	var err_ error
+
+	var data_ GetComponentTypesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetCustomFieldDefinition.
+const GetCustomFieldDefinition_Operation = `
+query GetCustomFieldDefinition ($cloudId: ID!, $id: ID!) {
+	compass {
+		customFieldDefinition(query: {cloudId:$cloudId,id:$id}) {
+			__typename
+			... on CompassCustomFieldDefinition {
+				id
+				name
+				description
+				componentTypes
+			}
+			... on CompassCustomSingleSelectFieldDefinition {
+				options {
+					id
+					value
+				}
+			}
+			... on CompassCustomMultiSelectFieldDefinition {
+				options {
+					id
+					value
+				}
+			}
+			... on QueryError {
+				message
+				extensions {
+					__typename
+					statusCode
+					errorType
+				}
+			}
+		}
+	}
+}
+`
+
+func GetCustomFieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	id string,
+) (*GetCustomFieldDefinitionResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetCustomFieldDefinition",
+		Query:  GetCustomFieldDefinition_Operation,
+		Variables: &__GetCustomFieldDefinitionInput{
+			CloudId: cloudId,
+			Id:      id,
+		},
+	}
+	var err_ error
+
+	var data_ GetCustomFieldDefinitionResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetScorecard.
+const GetScorecard_Operation = `
+query GetScorecard ($id: ID!) {
+	compass {
+		scorecard(id: $id) {
+			__typename
+			... on CompassScorecard {
+				id
+				name
+				description
+				importance
+				componentTypeIds
+				owner {
+					__typename
+					accountId
+				}
+				criterias {
+					__typename
+					id
+					weight
+					... on CompassHasLinkScorecardCriteria {
+						linkType
+					}
+					... on CompassHasMetricValueScorecardCriteria {
+						metricDefinitionId
+						comparator
+						comparatorValue
+					}
+					... on CompassHasCustomBooleanFieldScorecardCriteria {
+						customFieldDefinition {
+							id
+						}
+						booleanComparator
+						booleanComparatorValue
+					}
+					... on CompassHasCustomNumberFieldScorecardCriteria {
+						customFieldDefinition {
+							id
+						}
+						numberComparator
+						numberComparatorValue
+					}
+					... on CompassHasCustomTextFieldScorecardCriteria {
+						customFieldDefinition {
+							id
+						}
+					}
+				}
+			}
+			... on QueryError {
+				message
+				extensions {
+					__typename
+					statusCode
+					errorType
+				}
+			}
+		}
+	}
+}
+`
+
+func GetScorecard(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*GetScorecardResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetScorecard",
+		Query:  GetScorecard_Operation,
+		Variables: &__GetScorecardInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ GetScorecardResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by GetTenantContexts.
+const GetTenantContexts_Operation = `
+query GetTenantContexts ($hostNames: [String!]!) {
+	tenantContexts(hostNames: $hostNames) {
+		cloudId
+	}
+}
+`
+
+// Used by GetCloudIDByTenant to look up the cloud ID of a site by its host name
+func GetTenantContexts(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	hostNames []string,
+) (*GetTenantContextsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "GetTenantContexts",
+		Query:  GetTenantContexts_Operation,
+		Variables: &__GetTenantContextsInput{
+			HostNames: hostNames,
+		},
+	}
+	var err_ error
+
+	var data_ GetTenantContextsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by RemoveComponentLabels.
+const RemoveComponentLabels_Operation = `
+mutation RemoveComponentLabels ($input: RemoveCompassComponentLabelsInput!) {
+	compass {
+		removeComponentLabels(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func RemoveComponentLabels(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input RemoveCompassComponentLabelsInput,
+) (*RemoveComponentLabelsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "RemoveComponentLabels",
+		Query:  RemoveComponentLabels_Operation,
+		Variables: &__RemoveComponentLabelsInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ RemoveComponentLabelsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by SearchComponents.
+const SearchComponents_Operation = `
+query SearchComponents ($cloudId: String!, $query: CompassSearchComponentQuery!) {
+	compass {
+		searchComponents(cloudId: $cloudId, query: $query) {
+			__typename
+			... on CompassSearchComponentConnection {
+				nodes {
+					component {
+						id
+						name
+						slug
+						description
+						typeId
+						ownerId
+						labels {
+							name
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+			... on QueryError {
+				message
+			}
+		}
+	}
+}
+`
+
+// Full text search used by the data sources, the pages are walked with Paginate
+func SearchComponents(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	query CompassSearchComponentQuery,
+) (*SearchComponentsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "SearchComponents",
+		Query:  SearchComponents_Operation,
+		Variables: &__SearchComponentsInput{
+			CloudId: cloudId,
+			Query:   query,
+		},
+	}
+	var err_ error
+
+	var data_ SearchComponentsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by SearchTeams.
+const SearchTeams_Operation = `
+query SearchTeams ($organizationId: ID!, $siteId: String!, $query: String, $first: Int, $after: String) {
+	team {
+		teamSearchV2(organizationId: $organizationId, siteId: $siteId, filter: {query:$query}, first: $first, after: $after) {
+			nodes {
+				team {
+					id
+					displayName
+					description
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+// Teams are not part of the Compass API, they are searched in the organization of the site
+func SearchTeams(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	siteId string,
+	query string,
+	first int,
+	after string,
+) (*SearchTeamsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "SearchTeams",
+		Query:  SearchTeams_Operation,
+		Variables: &__SearchTeamsInput{
+			OrganizationId: organizationId,
+			SiteId:         siteId,
+			Query:          query,
+			First:          first,
+			After:          after,
+		},
+	}
+	var err_ error
+
+	var data_ SearchTeamsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by UpdateComponent.
+const UpdateComponent_Operation = `
+mutation UpdateComponent ($input: UpdateCompassComponentInput!) {
+	compass {
+		updateComponent(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+// Only the changed fields of the component are sent, ownerId is always sent and null clears it
+func UpdateComponent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input UpdateCompassComponentInput,
+) (*UpdateComponentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateComponent",
+		Query:  UpdateComponent_Operation,
+		Variables: &__UpdateComponentInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ UpdateComponentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by UpdateComponentLink.
+const UpdateComponentLink_Operation = `
+mutation UpdateComponentLink ($input: UpdateCompassComponentLinkInput!) {
+	compass {
+		updateComponentLink(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+// Only the changed fields of the link are sent, objectId is sent as null to clear it
+func UpdateComponentLink(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input UpdateCompassComponentLinkInput,
+) (*UpdateComponentLinkResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateComponentLink",
+		Query:  UpdateComponentLink_Operation,
+		Variables: &__UpdateComponentLinkInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ UpdateComponentLinkResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by UpdateComponentType.
+const UpdateComponentType_Operation = `
+mutation UpdateComponentType ($input: UpdateCompassComponentTypeInput!) {
+	compass {
+		updateComponentType(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+// The type is not part of UpdateCompassComponentInput, built-in types are set by their enum
+// value and custom types by their ID
+func UpdateComponentType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input UpdateCompassComponentTypeInput,
+) (*UpdateComponentTypeResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateComponentType",
+		Query:  UpdateComponentType_Operation,
+		Variables: &__UpdateComponentTypeInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ UpdateComponentTypeResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by UpdateComponentTypeMetadata.
+const UpdateComponentTypeMetadata_Operation = `
+mutation UpdateComponentTypeMetadata ($input: UpdateCompassComponentTypeMetadataInput!) {
+	compass {
+		updateComponentTypeMetadata(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+// Only the changed fields of the type are sent, an empty description clears it
+func UpdateComponentTypeMetadata(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input UpdateCompassComponentTypeMetadataInput,
+) (*UpdateComponentTypeMetadataResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateComponentTypeMetadata",
+		Query:  UpdateComponentTypeMetadata_Operation,
+		Variables: &__UpdateComponentTypeMetadataInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ UpdateComponentTypeMetadataResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by UpdateCustomFieldDefinition.
+const UpdateCustomFieldDefinition_Operation = `
+mutation UpdateCustomFieldDefinition ($input: CompassUpdateCustomFieldDefinitionInput!) {
+	compass {
+		updateCustomFieldDefinition(input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+func UpdateCustomFieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input map[string]interface{},
+) (*UpdateCustomFieldDefinitionResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateCustomFieldDefinition",
+		Query:  UpdateCustomFieldDefinition_Operation,
+		Variables: &__UpdateCustomFieldDefinitionInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ UpdateCustomFieldDefinitionResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by UpdateScorecard.
+const UpdateScorecard_Operation = `
+mutation UpdateScorecard ($cloudId: ID!, $scorecardId: ID!, $input: UpdateCompassScorecardInput!) {
+	compass {
+		updateScorecard(cloudId: $cloudId, scorecardId: $scorecardId, input: $input) {
+			success
+			errors {
+				... MutationErrorFields
+			}
+		}
+	}
+}
+fragment MutationErrorFields on MutationError {
+	message
+	extensions {
+		__typename
+		statusCode
+		errorType
+	}
+}
+`
+
+// Only the changed fields and criteria of the scorecard are sent, ownerId is always sent and
+// null clears it
+func UpdateScorecard(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	cloudId string,
+	scorecardId string,
+	input UpdateCompassScorecardInput,
+) (*UpdateScorecardResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateScorecard",
+		Query:  UpdateScorecard_Operation,
+		Variables: &__UpdateScorecardInput{
+			CloudId:     cloudId,
+			ScorecardId: scorecardId,
+			Input:       input,
+		},
+	}
+	var err_ error
+
+	var data_ UpdateScorecardResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// Client implements graphql.Client, so the typed operations of generated.go share the
+// authentication, retries, rate limiting and logging of ExecuteQuery.
+var _ graphql.Client = (*Client)(nil)
+
+// MakeRequest executes a generated operation through ExecuteQuery. Errors are returned
+// as they are, so IsNotFound and friends work with the typed operations as well.
+func (c *Client) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	var variables map[string]interface{}
+	if req.Variables != nil {
+		variablesJSON, err := json.Marshal(req.Variables)
+		if err != nil {
+			return fmt.Errorf("failed to marshal variables: %w", err)
+		}
+
+		// Numbers are kept as json.Number, so they are sent exactly as genqlient encoded them
+		decoder := json.NewDecoder(bytes.NewReader(variablesJSON))
+		decoder.UseNumber()
+		if err := decoder.Decode(&variables); err != nil {
+			return fmt.Errorf("failed to decode variables: %w", err)
+		}
+	}
+
+	data, err := c.ExecuteQuery(ctx, req.Query, variables)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, resp.Data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s response: %w", req.OpName, err)
+	}
+
+	return nil
+}
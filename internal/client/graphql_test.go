@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newRecordingServer returns a server answering every request with body and recording the
+// variables of the last request.
+func newRecordingServer(t *testing.T, body string) (*httptest.Server, *map[string]interface{}) {
+	t.Helper()
+	var variables map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		variables = req.Variables
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &variables
+}
+
+func TestGetCloudIDByTenant(t *testing.T) {
+	server, variables := newRecordingServer(t, `{"data":{"tenantContexts":[{"cloudId":"cloud-1"}]}}`)
+	c := newTestClient(t, server.URL)
+
+	cloudID, err := c.GetCloudIDByTenant(context.Background(), "temabit")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cloudID != "cloud-1" {
+		t.Fatalf("unexpected cloud ID: %s", cloudID)
+	}
+
+	hostNames, _ := (*variables)["hostNames"].([]interface{})
+	if len(hostNames) != 1 || hostNames[0] != "temabit.atlassian.net" {
+		t.Fatalf("unexpected hostNames variable: %v", (*variables)["hostNames"])
+	}
+}
+
+func TestMakeRequest_SendsGeneratedInput(t *testing.T) {
+	server, variables := newRecordingServer(t, `{"data":{"compass":{"updateComponentLink":{"success":false,"errors":[{"message":"bad url","extensions":{"__typename":"SomeNewExtension","statusCode":400,"errorType":"BAD_REQUEST"}}]}}}}`)
+	c := newTestClient(t, server.URL)
+
+	name := "Docs"
+	response, err := UpdateComponentLink(context.Background(), c, UpdateCompassComponentLinkInput{
+		ComponentId: "component-1",
+		Link:        UpdateCompassLinkInput{Id: "link-1", Name: &name},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Unchanged fields are left out, objectId is sent as null
+	input, _ := (*variables)["input"].(map[string]interface{})
+	link, _ := input["link"].(map[string]interface{})
+	if _, ok := link["url"]; ok {
+		t.Fatalf("expected url to be left out, got %v", link)
+	}
+	if objectID, ok := link["objectId"]; !ok || objectID != nil {
+		t.Fatalf("expected objectId to be null, got %v", link)
+	}
+	if link["name"] != "Docs" || link["id"] != "link-1" {
+		t.Fatalf("unexpected link input: %v", link)
+	}
+
+	// Extension types missing from the vendored schema are decoded as well
+	payload := response.Compass.UpdateComponentLink
+	if payload.Success || len(payload.Errors) != 1 || payload.Errors[0].Extensions.ErrorType != "BAD_REQUEST" || payload.Errors[0].Extensions.StatusCode != 400 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestMakeRequest_ReturnsTypedErrors(t *testing.T) {
+	server, _ := newRecordingServer(t, `{"errors":[{"message":"Component not found","extensions":{"statusCode":404,"errorType":"NOT_FOUND"}}]}`)
+	c := newTestClient(t, server.URL)
+
+	_, err := GetComponentLinks(context.Background(), c, "component-1")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "[REDACTED]"
+
+// operationPattern matches the operation type and name of a GraphQL document,
+// e.g. "mutation CreateComponent(" or "query GetCloudId(".
+var operationPattern = regexp.MustCompile(`^\s*(query|mutation)\b\s*(\w*)`)
+
+// operationName returns the name of the GraphQL operation, or the operation type
+// for anonymous operations.
+func operationName(query string) string {
+	m := operationPattern.FindStringSubmatch(query)
+	if m == nil {
+		// Shorthand syntax, e.g. "{ compass { ... } }"
+		return "query"
+	}
+	if m[2] != "" {
+		return m[2]
+	}
+	return m[1]
+}
+
+// isMutation reports whether the GraphQL document is a mutation.
+func isMutation(query string) bool {
+	m := operationPattern.FindStringSubmatch(query)
+	return m != nil && m[1] == "mutation"
+}
+
+// logContext returns a context whose log entries carry the operation name and have every
+// credential of the client masked, so request and response bodies can be logged safely.
+func (c *Client) logContext(ctx context.Context, operation string) context.Context {
+	ctx = tflog.SetField(ctx, "graphql_operation", operation)
+	if secrets := c.auth.secrets(); len(secrets) > 0 {
+		ctx = tflog.MaskLogStrings(ctx, secrets...)
+	}
+	return ctx
+}
+
+// redactHeaders returns a copy of the headers that is safe to log.
+func redactHeaders(header http.Header) http.Header {
+	logged := header.Clone()
+	if logged.Get("Authorization") != "" {
+		logged.Set("Authorization", redacted)
+	}
+	return logged
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestOperationName(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "\n\t\tmutation CreateComponent($cloudId: ID!) { compass { x } }", want: "CreateComponent"},
+		{query: "query GetCloudId($hostNames: [String!]!) { tenantContexts { cloudId } }", want: "GetCloudId"},
+		{query: "query { ok }", want: "query"},
+		{query: "mutation{ ok }", want: "mutation"},
+		{query: "{ ok }", want: "query"},
+	}
+
+	for _, tt := range tests {
+		if got := operationName(tt.query); got != tt.want {
+			t.Errorf("operationName(%q): expected %s, got %s", tt.query, tt.want, got)
+		}
+	}
+}
+
+func TestExecuteQuery_Logging(t *testing.T) {
+	server, _ := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+	c := newTestClient(t, server.URL)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	query := "mutation CreateComponent($name: String!) { ok }"
+	if _, err := c.ExecuteQuery(ctx, query, map[string]interface{}{"name": "svc-a"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	messages := map[string]map[string]interface{}{}
+	for _, entry := range entries {
+		if entry["graphql_operation"] != "CreateComponent" {
+			t.Errorf("expected operation name on every entry, got %v", entry)
+		}
+		messages[entry["@message"].(string)] = entry
+	}
+
+	sent, ok := messages["Sending GraphQL request"]
+	if !ok || sent["graphql_variables"] != `{"name":"svc-a"}` {
+		t.Fatalf("expected request with variables to be logged, got %v", sent)
+	}
+	if _, ok := messages["Retrying GraphQL request"]; !ok {
+		t.Fatal("expected retry to be logged")
+	}
+	if completed, ok := messages["GraphQL request completed"]; !ok || completed["duration_ms"] == nil {
+		t.Fatalf("expected timing to be logged, got %v", completed)
+	}
+	if body, ok := messages["GraphQL response body"]; !ok || body["@level"] != "trace" || body["http_response_body"] != `{"data":{"ok":true}}` {
+		t.Fatalf("expected response body at trace level, got %v", body)
+	}
+}
+
+func TestExecuteQuery_LoggingRedactsCredentials(t *testing.T) {
+	server, _ := newFlakyServer(t, 0, http.StatusOK, nil)
+	c := newTestClient(t, server.URL)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	// The token also shows up in the variables, e.g. when it was copied into a description
+	if _, err := c.ExecuteQuery(ctx, "query { ok }", map[string]interface{}{"description": "test-token"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	logged := output.String()
+	if !strings.Contains(logged, "http_request_headers") {
+		t.Fatalf("expected request headers to be logged, got %s", logged)
+	}
+	// "test@example.com:test-token" encoded in Base64
+	for _, secret := range []string{"test-token", "dGVzdEBleGFtcGxlLmNvbTp0ZXN0LXRva2Vu"} {
+		if strings.Contains(logged, secret) {
+			t.Fatalf("expected %s to be redacted, got %s", secret, logged)
+		}
+	}
+}
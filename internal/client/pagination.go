@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// PageInfo holds the cursor information returned by GraphQL connection fields.
+type PageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// PageFunc fetches a single page of a connection starting after the given cursor
+// (empty for the first page) and returns the page information of that page.
+type PageFunc func(ctx context.Context, cursor string) (PageInfo, error)
+
+// Paginate calls fetch for every page of a connection until there are no more pages.
+// Collecting the nodes of each page is up to fetch.
+func Paginate(ctx context.Context, fetch PageFunc) error {
+	cursor := ""
+	seen := map[string]bool{}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pageInfo, err := fetch(ctx, cursor)
+		if err != nil {
+			return err
+		}
+
+		if !pageInfo.HasNextPage {
+			return nil
+		}
+
+		// Guard against APIs returning the same cursor again, which would loop forever
+		if pageInfo.EndCursor == "" || seen[pageInfo.EndCursor] {
+			return fmt.Errorf("pagination did not advance: cursor %q was already requested", pageInfo.EndCursor)
+		}
+		seen[pageInfo.EndCursor] = true
+		cursor = pageInfo.EndCursor
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	pages := map[string]struct {
+		items    []string
+		pageInfo PageInfo
+	}{
+		"":   {items: []string{"a", "b"}, pageInfo: PageInfo{HasNextPage: true, EndCursor: "c1"}},
+		"c1": {items: []string{"c", "d"}, pageInfo: PageInfo{HasNextPage: true, EndCursor: "c2"}},
+		"c2": {items: []string{"e"}, pageInfo: PageInfo{HasNextPage: false}},
+	}
+
+	var items []string
+	err := Paginate(context.Background(), func(ctx context.Context, cursor string) (PageInfo, error) {
+		page, ok := pages[cursor]
+		if !ok {
+			return PageInfo{}, fmt.Errorf("unexpected cursor %q", cursor)
+		}
+		items = append(items, page.items...)
+		return page.pageInfo, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(items) != "[a b c d e]" {
+		t.Errorf("unexpected items: %v", items)
+	}
+}
+
+func TestPaginate_RepeatedCursor(t *testing.T) {
+	calls := 0
+	err := Paginate(context.Background(), func(ctx context.Context, cursor string) (PageInfo, error) {
+		calls++
+		return PageInfo{HasNextPage: true, EndCursor: "same"}, nil
+	})
+	if err == nil {
+		t.Fatal("expected error for a cursor that does not advance")
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls before detecting the loop, got %d", calls)
+	}
+}
+
+func TestPaginate_FetchError(t *testing.T) {
+	err := Paginate(context.Background(), func(ctx context.Context, cursor string) (PageInfo, error) {
+		return PageInfo{}, fmt.Errorf("boom")
+	})
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("expected fetch error to be returned, got %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultRequestsPerSecond is the number of requests per second a client sends at most
+const DefaultRequestsPerSecond = 10
+
+// WithRateLimit limits the requests sent by the client to requestsPerSecond using a token
+// bucket, allowing bursts of up to requestsPerSecond requests. A value of 0 disables the limit.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(c *Client) {
+		c.limiter = newLimiter(requestsPerSecond)
+	}
+}
+
+// newLimiter returns a token bucket limiter for the given rate, or nil when it is not limited.
+func newLimiter(requestsPerSecond float64) *rate.Limiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	burst := max(int(math.Ceil(requestsPerSecond)), 1)
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a rate limited or failed request is retried
+	DefaultMaxRetries = 4
+	// DefaultRetryMaxWait is the longest time to wait before retrying a request
+	DefaultRetryMaxWait = 30 * time.Second
+
+	defaultRetryMinWait = 1 * time.Second
+)
+
+// WithRetry sets how often requests answered with 429 or 5xx are retried and the
+// longest time to wait between two attempts. A maxRetries of 0 disables retries.
+// Mutations are only retried on 429 and 503, see shouldRetry.
+func WithRetry(maxRetries int, maxWait time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryMaxWait = maxWait
+	}
+}
+
+// shouldRetry reports whether a response with the given status code is worth retrying.
+// 501 Not Implemented will not succeed on a later attempt. Mutations are not idempotent, so
+// they are only retried on 429 and 503, which Compass answers before processing the request.
+// Other 5xx responses may arrive after the write was committed, replaying them could create
+// the same object twice.
+func shouldRetry(statusCode int, mutation bool) bool {
+	if statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable {
+		return true
+	}
+	return !mutation && statusCode >= 500 && statusCode != http.StatusNotImplemented
+}
+
+// retryWait returns how long to wait before the given retry attempt (starting at 0).
+// The wait requested by the server through Retry-After or X-RateLimit-Reset is used when
+// present, otherwise an exponential backoff with jitter. The result never exceeds maxWait.
+func (c *Client) retryWait(attempt int, header http.Header) time.Duration {
+	if wait, ok := serverRetryWait(header, time.Now()); ok {
+		return min(wait, c.retryMaxWait)
+	}
+
+	backoff := c.retryMaxWait
+	if attempt < 32 {
+		backoff = min(c.retryMinWait<<attempt, c.retryMaxWait)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Half of the backoff is randomized so concurrent requests do not retry in lockstep
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// serverRetryWait reads the wait requested by the server. Retry-After may hold seconds or an
+// HTTP date, Atlassian's X-RateLimit-Reset holds the ISO 8601 time the limit resets at.
+func serverRetryWait(header http.Header, now time.Time) (time.Duration, bool) {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now), 0), true
+		}
+	}
+
+	if v := header.Get("X-RateLimit-Reset"); v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return max(t.Sub(now), 0), true
+		}
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,24 @@
+package client
+
+// ScorecardCriterion is a member of the CompassScorecardCriteria interface returned on
+// scorecards. Only the fields of the member named by TypeName are populated. The criteria of the
+// GetScorecard query are bound to it, see operations/scorecard.graphql.
+type ScorecardCriterion struct {
+	TypeName               string                         `json:"__typename"`
+	ID                     string                         `json:"id"`
+	Weight                 int                            `json:"weight"`
+	LinkType               string                         `json:"linkType,omitempty"`
+	MetricDefinitionID     string                         `json:"metricDefinitionId,omitempty"`
+	Comparator             string                         `json:"comparator,omitempty"`
+	ComparatorValue        *float64                       `json:"comparatorValue,omitempty"`
+	CustomFieldDefinition  *ScorecardCriterionCustomField `json:"customFieldDefinition,omitempty"`
+	BooleanComparator      string                         `json:"booleanComparator,omitempty"`
+	BooleanComparatorValue *bool                          `json:"booleanComparatorValue,omitempty"`
+	NumberComparator       string                         `json:"numberComparator,omitempty"`
+	NumberComparatorValue  *float64                       `json:"numberComparatorValue,omitempty"`
+}
+
+// ScorecardCriterionCustomField references the custom field definition checked by a criterion.
+type ScorecardCriterionCustomField struct {
+	ID string `json:"id"`
+}
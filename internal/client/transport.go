@@ -0,0 +1,112 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// TransportConfig holds the proxy and TLS settings used by NewTransport.
+type TransportConfig struct {
+	// ProxyURL is the proxy all requests are sent through. When empty, the proxy is taken
+	// from the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// CACertFile is a PEM file with CA certificates trusted in addition to the system ones
+	CACertFile string
+	// InsecureSkipVerify disables the verification of the server certificate
+	InsecureSkipVerify bool
+	// ClientCert and ClientKey are the PEM encoded certificate and key used for mutual TLS,
+	// either the PEM content or the path of a PEM file
+	ClientCert string
+	ClientKey  string
+}
+
+// WithTransport sends all requests of the client, including OAuth token requests, through
+// the given RoundTripper, e.g. one created with NewTransport or wrapping it.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithTimeout sets the time limit of a single request. Retries get a time limit of their own.
+// A timeout of 0 means no time limit.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// NewTransport returns a copy of http.DefaultTransport configured with the given proxy and
+// TLS settings.
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL '%s': %w", config.ProxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Only meant for test environments with self-signed certificates
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertFile != "" {
+		caCert, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in '%s'", config.CACertFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, fmt.Errorf("client certificate and client key must be set together")
+		}
+
+		certPEM, err := readPEM(config.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client certificate: %w", err)
+		}
+		keyPEM, err := readPEM(config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client key: %w", err)
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// readPEM returns the given value if it holds PEM content, otherwise the content of the file it points to.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
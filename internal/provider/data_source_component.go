@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// searchComponentsPageSize is the number of components requested per page of searchComponents
+const searchComponentsPageSize = 50
+
+func dataSourceComponent() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComponentRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "slug"},
+				Description:  "ID (ARI) of the Compass component to look up",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "slug"},
+				Description:  "Exact name of the Compass component to look up. Must match exactly one component",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "slug"},
+				Description:  "Slug of the Compass component to look up",
+			},
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Cloud ID of the Atlassian site. Only used for lookups by name or slug. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the Compass component",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the Compass component (SERVICE, LIBRARY, APPLICATION, INFRASTRUCTURE, DATABASE, DOCUMENTATION). Empty for other component types",
+			},
+			"type_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the type of the Compass component",
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Owner ID of the Compass component",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels attached to the Compass component",
+			},
+			"links": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Links attached to the Compass component",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the link",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the link",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the link",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the link",
+						},
+						"object_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique ID of the object the link points to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComponentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	componentID := d.Get("id").(string)
+
+	// Resolve name or slug to a component ID through the search query
+	if componentID == "" {
+		cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+		if diags.HasError() {
+			return diags
+		}
+
+		name := d.Get("name").(string)
+		slug := d.Get("slug").(string)
+		text := name
+		if slug != "" {
+			text = slug
+		}
+
+		components, err := searchComponents(ctx, compassClient, cloudID, client.CompassSearchComponentQuery{
+			Query: text,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The search is fuzzy, so only exact matches are considered
+		var matches []string
+		for _, component := range components {
+			if (name != "" && component.Name == name) || (slug != "" && component.Slug == slug) {
+				matches = append(matches, component.Id)
+			}
+		}
+
+		if len(matches) == 0 {
+			return diag.Errorf("component '%s' not found", text)
+		}
+		if len(matches) > 1 {
+			return diag.Errorf("found %d components matching '%s', expected exactly one", len(matches), text)
+		}
+
+		componentID = matches[0]
+	}
+
+	component, exists, err := getComponent(ctx, compassClient, componentID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read component '%s': %w", componentID, err))
+	}
+	if !exists {
+		return diag.Errorf("component '%s' not found", componentID)
+	}
+
+	// Map typeId back to the enum value, only querying the type metadata when needed
+	componentType := componentTypeFromName(component.TypeID)
+	if componentType == "" && component.TypeID != "" {
+		cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+		if diags.HasError() {
+			return diags
+		}
+		componentType, err = providerConfig.ResolveComponentType(ctx, cloudID, component.TypeID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	labels := make([]string, 0, len(component.Labels))
+	for _, label := range component.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	d.SetId(component.ID)
+	d.Set("name", component.Name)
+	d.Set("slug", component.Slug)
+	d.Set("description", component.Description)
+	d.Set("type", componentType)
+	d.Set("type_id", component.TypeID)
+	d.Set("owner_id", component.OwnerID)
+	d.Set("labels", labels)
+	if err := d.Set("links", flattenComponentLinks(component.Links)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set links: %w", err))
+	}
+
+	return nil
+}
+
+// searchComponents runs the searchComponents query with the given CompassSearchComponentQuery
+// and returns the components of all pages.
+func searchComponents(ctx context.Context, compassClient *client.Client, cloudID string, query client.CompassSearchComponentQuery) ([]client.SearchedComponent, error) {
+	var components []client.SearchedComponent
+
+	err := client.Paginate(ctx, func(ctx context.Context, cursor string) (client.PageInfo, error) {
+		query.First = searchComponentsPageSize
+		query.After = cursor
+
+		response, err := client.SearchComponents(ctx, compassClient, cloudID, query)
+		if err != nil {
+			return client.PageInfo{}, fmt.Errorf("failed to search components: %w", err)
+		}
+
+		switch result := response.Compass.SearchComponents.(type) {
+		case *client.SearchComponentsCompassCompassCatalogQueryApiSearchComponentsCompassSearchComponentConnection:
+			for _, node := range result.Nodes {
+				components = append(components, node.Component)
+			}
+			return result.PageInfo, nil
+		case *client.SearchComponentsCompassCompassCatalogQueryApiSearchComponentsQueryError:
+			return client.PageInfo{}, fmt.Errorf("failed to search components: %s", result.Message)
+		default:
+			return client.PageInfo{}, fmt.Errorf("unexpected search components result %T", result)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceComponent_Read(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	// Seed components and a link (simulate that they exist in API)
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":          "cmp-1",
+		"name":        "payments-api",
+		"slug":        "payments-api",
+		"description": "Payments API",
+		"typeId":      "type-service",
+		"ownerId":     "team-1",
+		"labels":      []map[string]interface{}{{"name": "tier-1"}},
+	}
+	state.components["cmp-2"] = map[string]interface{}{
+		"id":     "cmp-2",
+		"name":   "payments-api-worker",
+		"slug":   "payments-worker",
+		"typeId": "type-service",
+	}
+	state.links["lnk-1"] = map[string]interface{}{
+		"id":          "lnk-1",
+		"componentId": "cmp-1",
+		"name":        "Repo",
+		"type":        "REPOSITORY",
+		"url":         "https://github.com/example/repo",
+		"objectId":    "",
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+data "compass_component" "by_id" {
+  id = "cmp-1"
+}
+
+data "compass_component" "by_name" {
+  name = "payments-api"
+}
+
+data "compass_component" "by_slug" {
+  slug = "payments-worker"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "name", "payments-api"),
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "description", "Payments API"),
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "owner_id", "team-1"),
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "type", "SERVICE"),
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "type_id", "type-service"),
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "labels.#", "1"),
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "links.#", "1"),
+					resource.TestCheckResourceAttr("data.compass_component.by_id", "links.0.type", "REPOSITORY"),
+					resource.TestCheckResourceAttr("data.compass_component.by_name", "id", "cmp-1"),
+					resource.TestCheckResourceAttr("data.compass_component.by_slug", "id", "cmp-2"),
+					resource.TestCheckResourceAttr("data.compass_component.by_slug", "name", "payments-api-worker"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceComponents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComponentsRead,
+		Schema: map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Cloud ID of the Atlassian site. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
+			},
+			"types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateComponentType},
+				Description: "Only return components of one of these types. Valid values: SERVICE, LIBRARY, APPLICATION, INFRASTRUCTURE, DATABASE, DOCUMENTATION",
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return components owned by this owner ID",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return components that have all of these labels",
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return components whose name starts with this prefix",
+			},
+			"components": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Components matching the filters, ordered by name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID (ARI) of the component",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the component",
+						},
+						"slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Slug of the component",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the component",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the component. Empty for types other than the built-in ones",
+						},
+						"type_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the type of the component",
+						},
+						"owner_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Owner ID of the component",
+						},
+						"labels": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Labels attached to the component",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	types := expandStringSet(d.Get("types").(*schema.Set))
+	ownerID := d.Get("owner_id").(string)
+	labels := expandStringSet(d.Get("labels").(*schema.Set))
+	namePrefix := d.Get("name_prefix").(string)
+
+	// Type, owner and labels are filtered by Compass through CompassSearchFilterInput.
+	// The name prefix narrows the full text search and is checked exactly below.
+	query := client.CompassSearchComponentQuery{
+		Query: namePrefix,
+	}
+	if len(types) > 0 {
+		query.FieldFilters = append(query.FieldFilters, client.CompassSearchFilterInput{
+			Name:   "type",
+			Filter: client.CompassFilterInput{In: types},
+		})
+	}
+	if ownerID != "" {
+		query.FieldFilters = append(query.FieldFilters, client.CompassSearchFilterInput{
+			Name:   "ownerId",
+			Filter: client.CompassFilterInput{Eq: ownerID},
+		})
+	}
+	if len(labels) > 0 {
+		query.FieldFilters = append(query.FieldFilters, client.CompassSearchFilterInput{
+			Name:   "labels",
+			Filter: client.CompassFilterInput{In: labels},
+		})
+	}
+
+	components, err := searchComponents(ctx, compassClient, cloudID, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]interface{}, 0, len(components))
+	for _, component := range components {
+		if namePrefix != "" && !strings.HasPrefix(component.Name, namePrefix) {
+			continue
+		}
+
+		// The labels filter matches components having any of the labels, only keep those having all
+		componentLabels := make([]string, 0, len(component.Labels))
+		hasLabel := map[string]bool{}
+		for _, label := range component.Labels {
+			componentLabels = append(componentLabels, label.Name)
+			hasLabel[label.Name] = true
+		}
+		hasAllLabels := true
+		for _, label := range labels {
+			if !hasLabel[label] {
+				hasAllLabels = false
+				break
+			}
+		}
+		if !hasAllLabels {
+			continue
+		}
+
+		// Map typeIds back to enum values, the type metadata is only queried when needed
+		componentType := ""
+		if component.TypeId != "" {
+			componentType, err = providerConfig.ResolveComponentType(ctx, cloudID, component.TypeId)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":          component.Id,
+			"name":        component.Name,
+			"slug":        component.Slug,
+			"description": component.Description,
+			"type":        componentType,
+			"type_id":     component.TypeId,
+			"owner_id":    component.OwnerId,
+			"labels":      componentLabels,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].(map[string]interface{})["name"].(string) < result[j].(map[string]interface{})["name"].(string)
+	})
+
+	// The data source has no ID of its own, derive a stable one from the filters
+	sort.Strings(types)
+	sort.Strings(labels)
+	d.SetId(fmt.Sprintf("%s/%d", cloudID, schema.HashString(fmt.Sprintf("%v|%s|%v|%s", types, ownerID, labels, namePrefix))))
+	d.Set("cloud_id", cloudID)
+	if err := d.Set("components", result); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set components: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceComponents_Read(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	// Seed more components than fit on one search page so pagination is exercised
+	for i := 1; i <= searchComponentsPageSize+5; i++ {
+		id := fmt.Sprintf("cmp-%03d", i)
+		labels := []map[string]interface{}{{"name": "team-a"}}
+		if i%2 == 0 {
+			labels = append(labels, map[string]interface{}{"name": "tier-1"})
+		}
+		state.components[id] = map[string]interface{}{
+			"id":      id,
+			"name":    fmt.Sprintf("svc-%03d", i),
+			"typeId":  "type-service",
+			"ownerId": "team-1",
+			"labels":  labels,
+		}
+	}
+	state.components["lib-1"] = map[string]interface{}{
+		"id":      "lib-1",
+		"name":    "lib-shared",
+		"typeId":  "type-library",
+		"ownerId": "team-2",
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+data "compass_components" "all" {}
+
+data "compass_components" "services" {
+  types    = ["SERVICE"]
+  owner_id = "team-1"
+}
+
+data "compass_components" "tier_1" {
+  labels = ["team-a", "tier-1"]
+}
+
+data "compass_components" "prefix" {
+  name_prefix = "lib-"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.compass_components.all", "components.#", fmt.Sprint(searchComponentsPageSize+6)),
+					resource.TestCheckResourceAttr("data.compass_components.services", "components.#", fmt.Sprint(searchComponentsPageSize+5)),
+					resource.TestCheckResourceAttr("data.compass_components.services", "components.0.name", "svc-001"),
+					resource.TestCheckResourceAttr("data.compass_components.tier_1", "components.#", fmt.Sprint((searchComponentsPageSize+5)/2)),
+					resource.TestCheckResourceAttr("data.compass_components.prefix", "components.#", "1"),
+					resource.TestCheckResourceAttr("data.compass_components.prefix", "components.0.id", "lib-1"),
+					resource.TestCheckResourceAttr("data.compass_components.prefix", "components.0.type", "LIBRARY"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTeam() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTeamRead,
+		Schema: map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Cloud ID of the Atlassian site the team is searched in. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the Atlassian organization the team belongs to",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the team. Must match exactly one team",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the team",
+			},
+		},
+	}
+}
+
+func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	displayName := d.Get("display_name").(string)
+
+	teams, err := searchTeams(ctx, compassClient, d.Get("organization_id").(string), cloudID, displayName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The search is fuzzy, so only exact display name matches are considered
+	var matches []client.Team
+	for _, team := range teams {
+		if team.DisplayName == displayName {
+			matches = append(matches, team)
+		}
+	}
+
+	if len(matches) == 0 {
+		return diag.Errorf("team with display name '%s' not found", displayName)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("found %d teams with display name '%s', expected exactly one", len(matches), displayName)
+	}
+
+	team := matches[0]
+	d.SetId(team.Id)
+	d.Set("cloud_id", cloudID)
+	d.Set("display_name", team.DisplayName)
+	d.Set("description", team.Description)
+
+	return nil
+}
+
+// searchTeams returns all teams of the organization found by the search for query. Every page
+// is read, as the fuzzy search may rank the exact match behind many similarly named teams.
+func searchTeams(ctx context.Context, compassClient *client.Client, organizationID, cloudID, query string) ([]client.Team, error) {
+	var teams []client.Team
+
+	err := client.Paginate(ctx, func(ctx context.Context, cursor string) (client.PageInfo, error) {
+		response, err := client.SearchTeams(ctx, compassClient, organizationID, cloudID, query, 50, cursor)
+		if err != nil {
+			return client.PageInfo{}, fmt.Errorf("failed to search teams: %w", err)
+		}
+
+		for _, node := range response.Team.TeamSearchV2.Nodes {
+			teams = append(teams, node.Team)
+		}
+		return response.Team.TeamSearchV2.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceTeam_Read(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	// Seed teams; the search is fuzzy so "Payments" also returns "Payments Platform"
+	state.teams = []map[string]interface{}{
+		{"id": "ari:cloud:identity::team/team-1", "displayName": "Payments", "description": "Payments team"},
+		{"id": "ari:cloud:identity::team/team-2", "displayName": "Payments Platform", "description": ""},
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	dataSourceName := "data.compass_team.payments"
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+data "compass_team" "payments" {
+  organization_id = "org-1"
+  display_name    = "Payments"
+}
+`, server.URL)
+
+	missing := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+data "compass_team" "missing" {
+  organization_id = "org-1"
+  display_name    = "Billing"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "ari:cloud:identity::team/team-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "Payments team"),
+					resource.TestCheckResourceAttr(dataSourceName, "cloud_id", state.cloudID),
+				),
+			},
+			{
+				Config:      missing,
+				ExpectError: regexp.MustCompile("team with display name 'Billing' not found"),
+			},
+		},
+	})
+}
+
+func TestDataSourceTeam_Paginated(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	// The search ranks many similarly named teams before the exact match
+	for i := 1; i <= 60; i++ {
+		state.teams = append(state.teams, map[string]interface{}{
+			"id":          fmt.Sprintf("ari:cloud:identity::team/similar-%d", i),
+			"displayName": fmt.Sprintf("Payments %d", i),
+			"description": "",
+		})
+	}
+	state.teams = append(state.teams, map[string]interface{}{
+		"id": "ari:cloud:identity::team/team-1", "displayName": "Payments", "description": "Payments team",
+	})
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+data "compass_team" "payments" {
+  organization_id = "org-1"
+  display_name    = "Payments"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.compass_team.payments", "id", "ari:cloud:identity::team/team-1"),
+				),
+			},
+		},
+	})
+}
@@ -3,31 +3,73 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	providerName = "compass"
+
+	authMethodBasic  = "basic"
+	authMethodOAuth  = "oauth"
+	authMethodBearer = "bearer"
 )
 
 // Provider returns a *schema.Provider.
 func New() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			"auth_method": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_AUTH_METHOD", authMethodBasic),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					authMethodBasic, authMethodOAuth, authMethodBearer,
+				}, false)),
+				Description: "How the provider authenticates: 'basic' (email and api_token), 'oauth' (OAuth 2.0 client credentials, client_id and client_secret, e.g. of a service account) or 'bearer' (access_token). Defaults to 'basic'. Can also be set via COMPASS_AUTH_METHOD environment variable.",
+			},
 			"email": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("COMPASS_EMAIL", nil),
-				Description: "Email address of your Atlassian account. Can also be set via COMPASS_EMAIL environment variable.",
+				Description: "Email address of your Atlassian account. Required for the 'basic' auth_method. Can also be set via COMPASS_EMAIL environment variable.",
 			},
 			"api_token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("COMPASS_API_TOKEN", nil),
-				Description: "API token for Atlassian Compass. Get it from https://id.atlassian.com/manage/api-tokens. Can also be set via COMPASS_API_TOKEN environment variable.",
+				Description: "API token for Atlassian Compass. Get it from https://id.atlassian.com/manage/api-tokens. Required for the 'basic' auth_method. Can also be set via COMPASS_API_TOKEN environment variable.",
+				Sensitive:   true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_CLIENT_ID", nil),
+				Description: "OAuth 2.0 client ID. Required for the 'oauth' auth_method. Can also be set via COMPASS_CLIENT_ID environment variable.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_CLIENT_SECRET", nil),
+				Description: "OAuth 2.0 client secret. Required for the 'oauth' auth_method. Can also be set via COMPASS_CLIENT_SECRET environment variable.",
+				Sensitive:   true,
+			},
+			"oauth_token_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_OAUTH_TOKEN_URL", client.DefaultOAuthTokenURL),
+				Description: "Endpoint OAuth 2.0 access tokens are requested from for the 'oauth' auth_method. Defaults to https://auth.atlassian.com/oauth/token. Can also be set via COMPASS_OAUTH_TOKEN_URL environment variable.",
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_ACCESS_TOKEN", nil),
+				Description: "OAuth 2.0 access token, sent as is and not refreshed. Required for the 'bearer' auth_method. Can also be set via COMPASS_ACCESS_TOKEN environment variable.",
 				Sensitive:   true,
 			},
 			"base_url": {
@@ -42,10 +84,81 @@ func New() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("COMPASS_TENANT", nil),
 				Description: "Tenant name for automatic cloud_id detection (e.g., 'temabit' for temabit.atlassian.net). Can also be set via COMPASS_TENANT environment variable.",
 			},
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_CLOUD_ID", nil),
+				Description: "Cloud ID of the Atlassian site used by all resources and data sources that do not set cloud_id. Takes precedence over tenant. Can also be set via COMPASS_CLOUD_ID environment variable.",
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     client.DefaultMaxRetries,
+				Description: "Number of times a request is retried when the API responds with 429 (rate limited) or a 5xx error. Set to 0 to disable retries. Defaults to 4.",
+			},
+			"retry_max_wait": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     int(client.DefaultRetryMaxWait / time.Second),
+				Description: "Maximum number of seconds to wait between two attempts of a request, including waits requested through Retry-After. Defaults to 30.",
+			},
+			"requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Default:     float64(client.DefaultRequestsPerSecond),
+				Description: "Maximum number of GraphQL requests per second sent by the provider, shared by all resource operations running in parallel. Set to 0 to disable the limit. Defaults to 10.",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     int(client.DefaultTimeout / time.Second),
+				Description: "Time limit in seconds of a single request, each retry gets its own. Set to 0 to disable the limit. Defaults to 30.",
+			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_HTTP_PROXY", nil),
+				Description: "URL of the proxy all requests are sent through (e.g., 'http://proxy.example.com:3128'). If not set, HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used. Can also be set via COMPASS_HTTP_PROXY environment variable.",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_CA_CERT_FILE", nil),
+				Description: "Path to a PEM file with CA certificates trusted in addition to the system ones, e.g. of a TLS intercepting proxy. Can also be set via COMPASS_CA_CERT_FILE environment variable.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable verification of the server certificate. Only use it for testing.",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_CLIENT_CERT", nil),
+				Description: "PEM encoded client certificate for mutual TLS, either the content or the path of a file. Must be set together with client_key. Can also be set via COMPASS_CLIENT_CERT environment variable.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COMPASS_CLIENT_KEY", nil),
+				Description: "PEM encoded private key of client_cert, either the content or the path of a file. Can also be set via COMPASS_CLIENT_KEY environment variable.",
+				Sensitive:   true,
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"compass_component":      resourceComponent(),
-			"compass_component_link": resourceComponentLink(),
+			"compass_component":               resourceComponent(),
+			"compass_component_link":          resourceComponentLink(),
+			"compass_component_links":         resourceComponentLinks(),
+			"compass_component_relationship":  resourceComponentRelationship(),
+			"compass_component_type":          resourceComponentType(),
+			"compass_custom_field_definition": resourceCustomFieldDefinition(),
+			"compass_scorecard":               resourceScorecard(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"compass_component":  dataSourceComponent(),
+			"compass_components": dataSourceComponents(),
+			"compass_team":       dataSourceTeam(),
 		},
 		ConfigureContextFunc: configureProvider,
 	}
@@ -55,9 +168,99 @@ func New() *schema.Provider {
 type ProviderConfig struct {
 	Client *client.Client
 	Tenant string
+	// CloudID is the cloud_id configured in the provider, or the one detected from Tenant.
+	// Use GetCloudID to read it.
+	CloudID string
+
+	mu sync.Mutex
+
+	// builtInComponentTypes maps the type IDs of the built-in component types to their enum
+	// values per cloud ID. Use ResolveComponentType to read it.
+	builtInComponentTypes map[string]map[string]string
+	componentTypesMu      sync.Mutex
+}
+
+// GetCloudID returns the cloud_id configured in the provider. If only tenant is configured,
+// the cloud_id is detected on the first call and reused by all later calls, so the
+// tenantContexts query is sent once per run instead of once per resource.
+func (p *ProviderConfig) GetCloudID(ctx context.Context) (string, error) {
+	// Concurrent resources wait for the detection running in the first one
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.CloudID != "" {
+		return p.CloudID, nil
+	}
+
+	if p.Tenant == "" {
+		return "", fmt.Errorf("cloud_id is required when neither cloud_id nor tenant is configured in provider")
+	}
+
+	// A failed detection is not cached, so a later call can still succeed
+	cloudID, err := p.Client.GetCloudIDByTenant(ctx, p.Tenant)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cloud_id from tenant '%s': %w", p.Tenant, err)
+	}
+
+	p.CloudID = cloudID
+	return cloudID, nil
+}
+
+// ResourceCloudID returns the cloud_id of a resource or data source. When it is not set (or not
+// known yet, e.g. on import), the cloud_id of the provider is used and saved to the state.
+func (p *ProviderConfig) ResourceCloudID(ctx context.Context, d *schema.ResourceData) (string, diag.Diagnostics) {
+	if v, ok := d.GetOk("cloud_id"); ok && v.(string) != "" {
+		return v.(string), nil
+	}
+
+	cloudID, err := p.GetCloudID(ctx)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	if err := d.Set("cloud_id", cloudID); err != nil {
+		return "", diag.FromErr(fmt.Errorf("failed to set cloud_id: %w", err))
+	}
+	return cloudID, nil
+}
+
+// ResolveComponentType maps a component typeId to its CompassComponentType enum value, or
+// returns an empty string for custom types. The type IDs of the built-in types are listed on
+// the first call for a site and reused by all later calls, so reading components does not
+// page through the component types of the site every time.
+func (p *ProviderConfig) ResolveComponentType(ctx context.Context, cloudID, typeID string) (string, error) {
+	if componentType := componentTypeFromName(typeID); componentType != "" {
+		return componentType, nil
+	}
+
+	p.componentTypesMu.Lock()
+	defer p.componentTypesMu.Unlock()
+
+	builtInTypes, ok := p.builtInComponentTypes[cloudID]
+	if !ok {
+		componentTypes, err := getComponentTypes(ctx, p.Client, cloudID)
+		if err != nil {
+			return "", err
+		}
+
+		// Built-in types do not change, custom types are left out so new ones need no refresh
+		builtInTypes = map[string]string{}
+		for _, t := range componentTypes {
+			if componentType := componentTypeFromName(t.Name); componentType != "" {
+				builtInTypes[t.Id] = componentType
+			}
+		}
+
+		if p.builtInComponentTypes == nil {
+			p.builtInComponentTypes = map[string]map[string]string{}
+		}
+		p.builtInComponentTypes[cloudID] = builtInTypes
+	}
+
+	return builtInTypes[typeID], nil
 }
 
 func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	authMethod := d.Get("auth_method").(string)
 	email := d.Get("email").(string)
 	apiToken := d.Get("api_token").(string)
 	baseURL := d.Get("base_url").(string)
@@ -65,22 +268,90 @@ func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}
 	if v, ok := d.GetOk("tenant"); ok {
 		tenant = v.(string)
 	}
+	cloudID := ""
+	if v, ok := d.GetOk("cloud_id"); ok {
+		cloudID = v.(string)
+	}
+
+	opts := []client.Option{}
+	switch authMethod {
+	case authMethodBasic:
+		if email == "" {
+			return nil, diag.FromErr(fmt.Errorf("email is required"))
+		}
 
-	if email == "" {
-		return nil, diag.FromErr(fmt.Errorf("email is required"))
+		if apiToken == "" {
+			return nil, diag.FromErr(fmt.Errorf("api_token is required"))
+		}
+	case authMethodOAuth:
+		clientID := d.Get("client_id").(string)
+		clientSecret := d.Get("client_secret").(string)
+		if clientID == "" || clientSecret == "" {
+			return nil, diag.FromErr(fmt.Errorf("client_id and client_secret are required when auth_method is 'oauth'"))
+		}
+		opts = append(opts, client.WithOAuthClientCredentials(clientID, clientSecret), client.WithOAuthTokenURL(d.Get("oauth_token_url").(string)))
+	case authMethodBearer:
+		accessToken := d.Get("access_token").(string)
+		if accessToken == "" {
+			return nil, diag.FromErr(fmt.Errorf("access_token is required when auth_method is 'bearer'"))
+		}
+		opts = append(opts, client.WithBearerToken(accessToken))
+	default:
+		return nil, diag.FromErr(fmt.Errorf("invalid auth_method: %s. Valid values are: basic, oauth, bearer", authMethod))
 	}
 
-	if apiToken == "" {
-		return nil, diag.FromErr(fmt.Errorf("api_token is required"))
+	maxRetries := d.Get("max_retries").(int)
+	if maxRetries < 0 {
+		return nil, diag.FromErr(fmt.Errorf("max_retries must not be negative"))
+	}
+
+	retryMaxWait := d.Get("retry_max_wait").(int)
+	if retryMaxWait < 0 {
+		return nil, diag.FromErr(fmt.Errorf("retry_max_wait must not be negative"))
+	}
+
+	requestsPerSecond := d.Get("requests_per_second").(float64)
+	if requestsPerSecond < 0 {
+		return nil, diag.FromErr(fmt.Errorf("requests_per_second must not be negative"))
+	}
+
+	timeout := d.Get("timeout").(int)
+	if timeout < 0 {
+		return nil, diag.FromErr(fmt.Errorf("timeout must not be negative"))
+	}
+
+	opts = append(opts,
+		client.WithRetry(maxRetries, time.Duration(retryMaxWait)*time.Second),
+		client.WithRateLimit(requestsPerSecond),
+		client.WithTimeout(time.Duration(timeout)*time.Second),
+	)
+
+	transportConfig := client.TransportConfig{
+		ProxyURL:           d.Get("http_proxy").(string),
+		CACertFile:         d.Get("ca_cert_file").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		ClientCert:         d.Get("client_cert").(string),
+		ClientKey:          d.Get("client_key").(string),
+	}
+	// The default transport of the client is kept unless proxy or TLS settings are configured
+	if transportConfig != (client.TransportConfig{}) {
+		transport, err := client.NewTransport(transportConfig)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("failed to configure HTTP transport: %w", err))
+		}
+		opts = append(opts, client.WithTransport(transport))
 	}
 
-	compassClient, err := client.NewClient(baseURL, email, apiToken)
+	// email and api_token are ignored by the client when another auth_method is used
+	compassClient, err := client.NewClient(baseURL, email, apiToken, opts...)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("failed to create Compass client: %w", err))
 	}
 
+	// cloud_id is detected from tenant lazily, so configuring the provider does not call the API
 	return &ProviderConfig{
-		Client: compassClient,
-		Tenant: tenant,
+		Client:  compassClient,
+		Tenant:  tenant,
+		CloudID: cloudID,
 	}, nil
 }
@@ -1,26 +1,66 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"testing"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // mockState holds simple in-memory data to emulate GraphQL resources.
 type mockState struct {
-	mu         sync.Mutex
-	cloudID    string
-	components map[string]map[string]interface{}
-	links      map[string]map[string]interface{}
+	mu            sync.Mutex
+	cloudID       string
+	components    map[string]map[string]interface{}
+	links         map[string]map[string]interface{}
+	relationships map[string]map[string]interface{}
+	fieldDefs     map[string]map[string]interface{}
+	types         map[string]map[string]interface{}
+	scorecards    map[string]map[string]interface{}
+	teams         []map[string]interface{}
+	// rateLimited is the number of upcoming requests answered with 429 Too Many Requests
+	rateLimited int
+	// tenantLookups counts the tenantContexts queries received
+	tenantLookups int
+	// componentTypeLookups counts the componentTypes queries received
+	componentTypeLookups int
+	// authorization is the Authorization header of the last request
+	authorization string
+	// failingMutations maps mutation field names (e.g. "createComponent") to the message
+	// they fail with, answered with success=false and an errors payload
+	failingMutations map[string]string
+	// omitCreatedLink makes createComponentLink answer without the createdComponentLink payload
+	omitCreatedLink bool
+	// hiddenLinkReads is the number of upcoming component links queries that do not list
+	// the links yet, as if they were not visible right after creation
+	hiddenLinkReads int
+	// criteriaCreated is the number of scorecard criteria created, used for their IDs
+	criteriaCreated int
 }
 
 func newMockState() *mockState {
 	return &mockState{
-		cloudID:    "cloud-123",
-		components: map[string]map[string]interface{}{},
-		links:      map[string]map[string]interface{}{},
+		cloudID:       "cloud-123",
+		components:    map[string]map[string]interface{}{},
+		links:         map[string]map[string]interface{}{},
+		relationships: map[string]map[string]interface{}{},
+		fieldDefs:     map[string]map[string]interface{}{},
+		types:         map[string]map[string]interface{}{},
+		scorecards:    map[string]map[string]interface{}{},
+
+		failingMutations: map[string]string{},
 	}
 }
 
@@ -51,8 +91,46 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 
 		q := req.Query
 
+		// Rate limiting, the client is expected to retry after the requested wait
+		state.mu.Lock()
+		state.authorization = r.Header.Get("Authorization")
+		limited := state.rateLimited > 0
+		if limited {
+			state.rateLimited--
+		}
+		state.mu.Unlock()
+		if limited {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		// Mutations failing with an errors payload
+		state.mu.Lock()
+		for mutation, message := range state.failingMutations {
+			if strings.Contains(q, mutation+"(") {
+				state.mu.Unlock()
+				writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+					"compass": map[string]interface{}{
+						mutation: map[string]interface{}{
+							"success": false,
+							"errors": []map[string]interface{}{{
+								"message":    message,
+								"extensions": map[string]interface{}{"statusCode": 400, "errorType": "BAD_REQUEST"},
+							}},
+						},
+					},
+				}})
+				return
+			}
+		}
+		state.mu.Unlock()
+
 		// Tenant to cloudId lookup
 		if strings.Contains(q, "tenantContexts") {
+			state.mu.Lock()
+			state.tenantLookups++
+			state.mu.Unlock()
 			// Always return one context with the configured cloudID
 			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
 				"tenantContexts": []map[string]string{{"cloudId": state.cloudID}},
@@ -62,10 +140,16 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 
 		// Create component
 		if strings.Contains(q, "createComponent(") {
-			vars := req.Variables
-			name, _ := vars["name"].(string)
-			description, _ := vars["description"].(string)
-			ownerId, _ := vars["ownerId"].(string)
+			// variables: { cloudId, input: { name, description?, type?, typeId?, ownerId?, customFields? } }
+			input, _ := req.Variables["input"].(map[string]interface{})
+			name, _ := input["name"].(string)
+			description, _ := input["description"].(string)
+			ownerId, _ := input["ownerId"].(string)
+			// Built-in types are stored as "type-<lowercase type>", custom types by their ID
+			typeID, _ := input["typeId"].(string)
+			if componentType, ok := input["type"].(string); ok && typeID == "" {
+				typeID = "type-" + strings.ToLower(componentType)
+			}
 			// Use a deterministic ID for simplicity
 			id := "cmp-1"
 			state.mu.Lock()
@@ -73,10 +157,13 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 				"id":          id,
 				"name":        name,
 				"description": description,
-				// API returns typeId in read; we store the provided type into TypeID for later read mapping behavior
-				"typeId":  "type-service",
+				// API returns typeId in read
+				"typeId":  typeID,
 				"ownerId": ownerId,
 			}
+			if customFields, ok := input["customFields"].([]interface{}); ok {
+				applyMockCustomFields(state.components[id], customFields)
+			}
 			state.mu.Unlock()
 
 			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
@@ -90,20 +177,26 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 			return
 		}
 
-		// Read component by id (only when links are not requested)
-		if strings.Contains(q, "query GetComponent(") && strings.Contains(q, "component(id:") && !strings.Contains(q, "links {") {
+		// Read component by id (the link resource uses the componentId variable instead)
+		if _, byID := req.Variables["id"]; byID && strings.Contains(q, "query GetComponent (") && strings.Contains(q, "component(id:") {
 			id := ""
 			if v, ok := req.Variables["id"].(string); ok {
 				id = v
 			}
 			state.mu.Lock()
-			comp := state.components[id]
+			var comp map[string]interface{}
+			if stored := state.components[id]; stored != nil {
+				comp = map[string]interface{}{"__typename": "CompassComponent"}
+				for k, v := range stored {
+					comp[k] = v
+				}
+				comp["links"] = mockComponentLinks(state, id)
+			}
 			state.mu.Unlock()
 			if comp == nil {
-				// Return empty object to simulate not found
 				writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
 					"compass": map[string]interface{}{
-						"component": map[string]interface{}{},
+						"component": mockNotFoundQueryError("component", id),
 					},
 				}})
 				return
@@ -138,6 +231,9 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 						comp["ownerId"] = ""
 					}
 				}
+				if customFields, ok := input["customFields"].([]interface{}); ok {
+					applyMockCustomFields(comp, customFields)
+				}
 				state.components[id] = comp
 			}
 			state.mu.Unlock()
@@ -152,6 +248,66 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 			return
 		}
 
+		// Update component type
+		if strings.Contains(q, "updateComponentType(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id, _ := input["id"].(string)
+			state.mu.Lock()
+			comp := state.components[id]
+			if comp != nil {
+				if typeID, ok := input["typeId"].(string); ok {
+					comp["typeId"] = typeID
+				} else if componentType, ok := input["type"].(string); ok {
+					comp["typeId"] = "type-" + strings.ToLower(componentType)
+				}
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"updateComponentType": map[string]interface{}{"success": comp != nil},
+				},
+			}})
+			return
+		}
+
+		// Add/remove component labels
+		if strings.Contains(q, "addComponentLabels(") || strings.Contains(q, "removeComponentLabels(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id, _ := input["componentId"].(string)
+			names, _ := input["labelNames"].([]interface{})
+			adding := strings.Contains(q, "addComponentLabels(")
+			state.mu.Lock()
+			if comp := state.components[id]; comp != nil {
+				current := map[string]bool{}
+				if labels, ok := comp["labels"].([]map[string]interface{}); ok {
+					for _, l := range labels {
+						current[l["name"].(string)] = true
+					}
+				}
+				for _, n := range names {
+					current[n.(string)] = adding
+				}
+				var labels []map[string]interface{}
+				for name, present := range current {
+					if present {
+						labels = append(labels, map[string]interface{}{"name": name})
+					}
+				}
+				comp["labels"] = labels
+			}
+			state.mu.Unlock()
+			field := "removeComponentLabels"
+			if adding {
+				field = "addComponentLabels"
+			}
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					field: map[string]interface{}{"success": true},
+				},
+			}})
+			return
+		}
+
 		// Delete component
 		if strings.Contains(q, "deleteComponent(") {
 			input, _ := req.Variables["input"].(map[string]interface{})
@@ -181,24 +337,27 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 			}
 			// Collect links for this component
 			state.mu.Lock()
-			var links []map[string]interface{}
-			for _, l := range state.links {
-				if l["componentId"] == componentId {
-					// Return only GraphQL fields
-					links = append(links, map[string]interface{}{
-						"id":       l["id"],
-						"name":     l["name"],
-						"type":     l["type"],
-						"url":      l["url"],
-						"objectId": l["objectId"],
-					})
-				}
+			exists := state.components[componentId] != nil
+			links := mockComponentLinks(state, componentId)
+			if state.hiddenLinkReads > 0 {
+				state.hiddenLinkReads--
+				links = nil
 			}
 			state.mu.Unlock()
+			if !exists {
+				writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+					"compass": map[string]interface{}{
+						"component": mockNotFoundQueryError("component", componentId),
+					},
+				}})
+				return
+			}
 			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
 				"compass": map[string]interface{}{
 					"component": map[string]interface{}{
-						"links": links,
+						"__typename": "CompassComponent",
+						"id":         componentId,
+						"links":      links,
 					},
 				},
 			}})
@@ -214,8 +373,12 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 			linkType, _ := link["type"].(string)
 			url, _ := link["url"].(string)
 			objectId, _ := link["objectId"].(string)
-			id := "lnk-1"
 			state.mu.Lock()
+			// Use the first free ID, so a recreated link gets the ID of the deleted one
+			id := ""
+			for i := 1; id == "" || state.links[id] != nil; i++ {
+				id = fmt.Sprintf("lnk-%d", i)
+			}
 			state.links[id] = map[string]interface{}{
 				"id":          id,
 				"componentId": componentId,
@@ -224,10 +387,23 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 				"url":         url,
 				"objectId":    objectId,
 			}
+			var createdLink interface{}
+			if !state.omitCreatedLink {
+				createdLink = map[string]interface{}{
+					"id":       id,
+					"name":     name,
+					"type":     linkType,
+					"url":      url,
+					"objectId": objectId,
+				}
+			}
 			state.mu.Unlock()
 			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
 				"compass": map[string]interface{}{
-					"createComponentLink": map[string]interface{}{"success": true},
+					"createComponentLink": map[string]interface{}{
+						"success":              true,
+						"createdComponentLink": createdLink,
+					},
 				},
 			}})
 			return
@@ -286,6 +462,558 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 			return
 		}
 
+		// Component relationships query (used by relationship read)
+		if strings.Contains(q, "query GetComponentRelationships (") {
+			id, _ := req.Variables["id"].(string)
+			query, _ := req.Variables["query"].(map[string]interface{})
+			state.mu.Lock()
+			if _, ok := state.components[id]; !ok {
+				state.mu.Unlock()
+				writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+					"compass": map[string]interface{}{
+						"component": mockNotFoundQueryError("component", id),
+					},
+				}})
+				return
+			}
+			var keys []string
+			for key, rel := range state.relationships {
+				if rel["startNodeId"] == id {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+
+			// Cursor is the index of the first node of the next page
+			start := 0
+			if after, ok := query["after"].(string); ok {
+				start, _ = strconv.Atoi(after)
+			}
+			end := len(keys)
+			if first, ok := query["first"].(float64); ok && start+int(first) < end {
+				end = start + int(first)
+			}
+			var nodes []map[string]interface{}
+			for _, key := range keys[start:end] {
+				rel := state.relationships[key]
+				nodes = append(nodes, map[string]interface{}{
+					"type":      rel["type"],
+					"startNode": map[string]interface{}{"__typename": "CompassComponent", "id": rel["startNodeId"]},
+					"endNode":   map[string]interface{}{"__typename": "CompassComponent", "id": rel["endNodeId"]},
+				})
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"component": map[string]interface{}{
+						"__typename": "CompassComponent",
+						"id":         id,
+						"relationships": map[string]interface{}{
+							"__typename": "CompassRelationshipConnection",
+							"nodes":      nodes,
+							"pageInfo": map[string]interface{}{
+								"hasNextPage": end < len(keys),
+								"endCursor":   strconv.Itoa(end),
+							},
+						},
+					},
+				},
+			}})
+			return
+		}
+
+		// Create relationship
+		if strings.Contains(q, "createRelationship(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			startNodeId, _ := input["startNodeId"].(string)
+			endNodeId, _ := input["endNodeId"].(string)
+			relType, _ := input["type"].(string)
+			state.mu.Lock()
+			state.relationships[startNodeId+"|"+endNodeId+"|"+relType] = map[string]interface{}{
+				"startNodeId": startNodeId,
+				"endNodeId":   endNodeId,
+				"type":        relType,
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"createRelationship": map[string]interface{}{"success": true},
+				},
+			}})
+			return
+		}
+
+		// Delete relationship
+		if strings.Contains(q, "deleteRelationship(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			startNodeId, _ := input["startNodeId"].(string)
+			endNodeId, _ := input["endNodeId"].(string)
+			relType, _ := input["type"].(string)
+			state.mu.Lock()
+			delete(state.relationships, startNodeId+"|"+endNodeId+"|"+relType)
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"deleteRelationship": map[string]interface{}{"success": true},
+				},
+			}})
+			return
+		}
+
+		// Create custom field definition
+		if strings.Contains(q, "createCustomFieldDefinition(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id := "cfd-1"
+			typeName := ""
+			state.mu.Lock()
+			for member, v := range input {
+				def, _ := v.(map[string]interface{})
+				name, _ := def["name"].(string)
+				description, _ := def["description"].(string)
+				var options []map[string]interface{}
+				rawOptions, _ := def["options"].([]interface{})
+				for i, o := range rawOptions {
+					value, _ := o.(map[string]interface{})["value"].(string)
+					options = append(options, map[string]interface{}{"id": fmt.Sprintf("opt-%d", i+1), "value": value})
+				}
+				typeName = "CompassCustom" + strings.ToUpper(member[:1]) + strings.TrimSuffix(member[1:], "FieldDefinition") + "FieldDefinition"
+				state.fieldDefs[id] = map[string]interface{}{
+					"__typename":     typeName,
+					"id":             id,
+					"name":           name,
+					"description":    description,
+					"componentTypes": def["componentTypes"],
+					"options":        options,
+				}
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"createCustomFieldDefinition": map[string]interface{}{
+						"success":               true,
+						"customFieldDefinition": map[string]interface{}{"__typename": typeName, "id": id},
+					},
+				},
+			}})
+			return
+		}
+
+		// Read custom field definition
+		if strings.Contains(q, "query GetCustomFieldDefinition (") {
+			id, _ := req.Variables["id"].(string)
+			state.mu.Lock()
+			var def interface{} = mockNotFoundQueryError("custom field definition", id)
+			if found := state.fieldDefs[id]; found != nil {
+				def = found
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"customFieldDefinition": def,
+				},
+			}})
+			return
+		}
+
+		// Update custom field definition
+		if strings.Contains(q, "updateCustomFieldDefinition(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			state.mu.Lock()
+			for _, v := range input {
+				upd, _ := v.(map[string]interface{})
+				id, _ := upd["id"].(string)
+				def := state.fieldDefs[id]
+				if def == nil {
+					continue
+				}
+				if name, ok := upd["name"].(string); ok {
+					def["name"] = name
+				}
+				if description, ok := upd["description"].(string); ok {
+					def["description"] = description
+				}
+				if componentTypes, ok := upd["componentTypes"]; ok {
+					def["componentTypes"] = componentTypes
+				}
+				options, _ := def["options"].([]map[string]interface{})
+				deleted := map[string]bool{}
+				rawDeletes, _ := upd["deleteOptions"].([]interface{})
+				for _, optionID := range rawDeletes {
+					deleted[optionID.(string)] = true
+				}
+				var kept []map[string]interface{}
+				for _, o := range options {
+					if !deleted[o["id"].(string)] {
+						kept = append(kept, o)
+					}
+				}
+				rawCreates, _ := upd["createOptions"].([]interface{})
+				for i, o := range rawCreates {
+					value, _ := o.(map[string]interface{})["value"].(string)
+					kept = append(kept, map[string]interface{}{"id": fmt.Sprintf("opt-new-%d", i+1), "value": value})
+				}
+				def["options"] = kept
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"updateCustomFieldDefinition": map[string]interface{}{"success": true},
+				},
+			}})
+			return
+		}
+
+		// Delete custom field definition
+		if strings.Contains(q, "deleteCustomFieldDefinition(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id, _ := input["id"].(string)
+			state.mu.Lock()
+			delete(state.fieldDefs, id)
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"deleteCustomFieldDefinition": map[string]interface{}{"success": true},
+				},
+			}})
+			return
+		}
+
+		// Team search (used by the compass_team data source)
+		if strings.Contains(q, "teamSearchV2(") {
+			query, _ := req.Variables["query"].(string)
+			state.mu.Lock()
+			var matches []map[string]interface{}
+			for _, team := range state.teams {
+				if strings.Contains(strings.ToLower(team["displayName"].(string)), strings.ToLower(query)) {
+					matches = append(matches, team)
+				}
+			}
+			state.mu.Unlock()
+
+			// Cursor is the index of the first node of the next page
+			start := 0
+			if after, ok := req.Variables["after"].(string); ok {
+				start, _ = strconv.Atoi(after)
+			}
+			end := len(matches)
+			if first, ok := req.Variables["first"].(float64); ok && start+int(first) < end {
+				end = start + int(first)
+			}
+			var nodes []map[string]interface{}
+			for _, team := range matches[start:end] {
+				nodes = append(nodes, map[string]interface{}{"team": team})
+			}
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"team": map[string]interface{}{
+					"teamSearchV2": map[string]interface{}{
+						"nodes": nodes,
+						"pageInfo": map[string]interface{}{
+							"hasNextPage": end < len(matches),
+							"endCursor":   strconv.Itoa(end),
+						},
+					},
+				},
+			}})
+			return
+		}
+
+		// Component search (used by the compass_component and compass_components data sources)
+		if strings.Contains(q, "searchComponents(") {
+			query, _ := req.Variables["query"].(map[string]interface{})
+			text, _ := query["query"].(string)
+			filters, _ := query["fieldFilters"].([]interface{})
+			state.mu.Lock()
+			var matches []map[string]interface{}
+			for _, comp := range state.components {
+				name, _ := comp["name"].(string)
+				slug, _ := comp["slug"].(string)
+				if text != "" && !strings.Contains(strings.ToLower(name), strings.ToLower(text)) && !strings.Contains(slug, text) {
+					continue
+				}
+				if mockMatchesFieldFilters(comp, filters) {
+					matches = append(matches, comp)
+				}
+			}
+			state.mu.Unlock()
+			sort.Slice(matches, func(i, j int) bool { return matches[i]["id"].(string) < matches[j]["id"].(string) })
+
+			// Cursor is the index of the first node of the next page
+			start := 0
+			if after, ok := query["after"].(string); ok {
+				start, _ = strconv.Atoi(after)
+			}
+			end := len(matches)
+			if first, ok := query["first"].(float64); ok && start+int(first) < end {
+				end = start + int(first)
+			}
+			var nodes []map[string]interface{}
+			for _, comp := range matches[start:end] {
+				nodes = append(nodes, map[string]interface{}{"component": comp})
+			}
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"searchComponents": map[string]interface{}{
+						"__typename": "CompassSearchComponentConnection",
+						"nodes":      nodes,
+						"pageInfo": map[string]interface{}{
+							"hasNextPage": end < len(matches),
+							"endCursor":   strconv.Itoa(end),
+						},
+					},
+				},
+			}})
+			return
+		}
+
+		// Create custom component type
+		if strings.Contains(q, "createComponentType(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id := "type-custom-" + strconv.Itoa(len(state.types)+1)
+			state.mu.Lock()
+			state.types[id] = map[string]interface{}{
+				"id":          id,
+				"name":        input["name"],
+				"description": input["description"],
+				"iconUrl":     fmt.Sprintf("https://example.com/icons/%v.svg", input["iconKey"]),
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"createComponentType": map[string]interface{}{
+						"success":              true,
+						"createdComponentType": map[string]interface{}{"id": id},
+					},
+				},
+			}})
+			return
+		}
+
+		// Update custom component type
+		if strings.Contains(q, "updateComponentTypeMetadata(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id, _ := input["id"].(string)
+			state.mu.Lock()
+			t := state.types[id]
+			if t != nil {
+				for _, k := range []string{"name", "description"} {
+					if v, ok := input[k]; ok {
+						t[k] = v
+					}
+				}
+				if v, ok := input["iconKey"]; ok {
+					t["iconUrl"] = fmt.Sprintf("https://example.com/icons/%v.svg", v)
+				}
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"updateComponentTypeMetadata": map[string]interface{}{"success": t != nil},
+				},
+			}})
+			return
+		}
+
+		// Delete custom component type
+		if strings.Contains(q, "deleteComponentType(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id, _ := input["id"].(string)
+			state.mu.Lock()
+			delete(state.types, id)
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"deleteComponentType": map[string]interface{}{"success": true},
+				},
+			}})
+			return
+		}
+
+		// Single component type (used by component type read)
+		if strings.Contains(q, "query GetComponentType (") {
+			id, _ := req.Variables["id"].(string)
+			state.mu.Lock()
+			var componentType interface{} = mockNotFoundQueryError("component type", id)
+			if t, ok := state.types[id]; ok {
+				found := map[string]interface{}{"__typename": "CompassComponentTypeObject"}
+				for k, v := range t {
+					found[k] = v
+				}
+				componentType = found
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"componentType": componentType,
+				},
+			}})
+			return
+		}
+
+		// Component types metadata (used to map typeId back to the type enum)
+		if strings.Contains(q, "componentTypes(") {
+			state.mu.Lock()
+			state.componentTypeLookups++
+			state.mu.Unlock()
+			var nodes []map[string]interface{}
+			for _, name := range []string{"Service", "Library", "Application", "Infrastructure", "Database", "Documentation"} {
+				nodes = append(nodes, map[string]interface{}{
+					"id":   "type-" + strings.ToLower(name),
+					"name": name,
+				})
+			}
+			state.mu.Lock()
+			var custom []map[string]interface{}
+			for _, t := range state.types {
+				custom = append(custom, t)
+			}
+			state.mu.Unlock()
+			sort.Slice(custom, func(i, j int) bool { return custom[i]["id"].(string) < custom[j]["id"].(string) })
+			nodes = append(nodes, custom...)
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"componentTypes": map[string]interface{}{
+						"__typename": "CompassComponentTypeConnection",
+						"nodes":      nodes,
+						"pageInfo":   map[string]interface{}{"hasNextPage": false},
+					},
+				},
+			}})
+			return
+		}
+
+		// Create scorecard
+		if strings.Contains(q, "createScorecard(") {
+			input, _ := req.Variables["input"].(map[string]interface{})
+			id := "scorecard-1"
+			state.mu.Lock()
+			scorecard := map[string]interface{}{
+				"__typename":       "CompassScorecard",
+				"id":               id,
+				"name":             input["name"],
+				"description":      input["description"],
+				"importance":       input["importance"],
+				"componentTypeIds": input["componentTypeIds"],
+			}
+			if ownerID, ok := input["ownerId"].(string); ok {
+				scorecard["owner"] = map[string]interface{}{"__typename": "AtlassianAccountUser", "accountId": ownerID}
+			}
+			var criteria []map[string]interface{}
+			rawCriteria, _ := input["criterias"].([]interface{})
+			for _, raw := range rawCriteria {
+				state.criteriaCreated++
+				criterion := mockScorecardCriterion(raw.(map[string]interface{}))
+				criterion["id"] = fmt.Sprintf("crit-%d", state.criteriaCreated)
+				criteria = append(criteria, criterion)
+			}
+			scorecard["criterias"] = criteria
+			state.scorecards[id] = scorecard
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"createScorecard": map[string]interface{}{
+						"success":          true,
+						"scorecardDetails": map[string]interface{}{"id": id},
+					},
+				},
+			}})
+			return
+		}
+
+		// Read scorecard
+		if strings.Contains(q, "query GetScorecard (") {
+			id, _ := req.Variables["id"].(string)
+			state.mu.Lock()
+			var scorecard interface{} = mockNotFoundQueryError("scorecard", id)
+			if stored := state.scorecards[id]; stored != nil {
+				scorecard = stored
+			}
+			// Encode while holding the lock, updates modify the stored scorecard
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"scorecard": scorecard,
+				},
+			}})
+			state.mu.Unlock()
+			return
+		}
+
+		// Update scorecard
+		if strings.Contains(q, "updateScorecard(") {
+			id, _ := req.Variables["scorecardId"].(string)
+			input, _ := req.Variables["input"].(map[string]interface{})
+			state.mu.Lock()
+			scorecard := state.scorecards[id]
+			if scorecard != nil {
+				for _, k := range []string{"name", "description", "importance", "componentTypeIds"} {
+					if v, ok := input[k]; ok {
+						scorecard[k] = v
+					}
+				}
+				if v, ok := input["ownerId"]; ok {
+					scorecard["owner"] = nil
+					if ownerID, ok := v.(string); ok {
+						scorecard["owner"] = map[string]interface{}{"__typename": "AtlassianAccountUser", "accountId": ownerID}
+					}
+				}
+
+				deleted := map[string]bool{}
+				rawDeletes, _ := input["deleteCriteria"].([]interface{})
+				for _, raw := range rawDeletes {
+					deleted[raw.(map[string]interface{})["id"].(string)] = true
+				}
+				updated := map[string]map[string]interface{}{}
+				rawUpdates, _ := input["updateCriteria"].([]interface{})
+				for _, raw := range rawUpdates {
+					for member, v := range raw.(map[string]interface{}) {
+						value := v.(map[string]interface{})
+						updated[value["id"].(string)] = map[string]interface{}{member: value}
+					}
+				}
+				var criteria []map[string]interface{}
+				current, _ := scorecard["criterias"].([]map[string]interface{})
+				for _, criterion := range current {
+					criterionID := criterion["id"].(string)
+					if deleted[criterionID] {
+						continue
+					}
+					if update := updated[criterionID]; update != nil {
+						criterion = mockScorecardCriterion(update)
+						criterion["id"] = criterionID
+					}
+					criteria = append(criteria, criterion)
+				}
+				rawCreates, _ := input["createCriteria"].([]interface{})
+				for _, raw := range rawCreates {
+					state.criteriaCreated++
+					criterion := mockScorecardCriterion(raw.(map[string]interface{}))
+					criterion["id"] = fmt.Sprintf("crit-%d", state.criteriaCreated)
+					criteria = append(criteria, criterion)
+				}
+				scorecard["criterias"] = criteria
+			}
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"updateScorecard": map[string]interface{}{"success": scorecard != nil},
+				},
+			}})
+			return
+		}
+
+		// Delete scorecard
+		if strings.Contains(q, "deleteScorecard(") {
+			id, _ := req.Variables["scorecardId"].(string)
+			state.mu.Lock()
+			delete(state.scorecards, id)
+			state.mu.Unlock()
+			writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{
+				"compass": map[string]interface{}{
+					"deleteScorecard": map[string]interface{}{"success": true},
+				},
+			}})
+			return
+		}
+
 		// Fallback: unsupported query
 		writeJSON(w, http.StatusOK, graphQLResponse{Data: map[string]interface{}{}})
 	})
@@ -293,8 +1021,488 @@ func startMockGraphQLServer(state *mockState) *httptest.Server {
 	return httptest.NewServer(handler)
 }
 
+// mockNotFoundQueryError returns the QueryError union member Compass answers with
+// for objects that do not exist.
+func mockNotFoundQueryError(kind, id string) map[string]interface{} {
+	return map[string]interface{}{
+		"__typename": "QueryError",
+		"message":    fmt.Sprintf("%s %s not found", kind, id),
+		"extensions": []map[string]interface{}{
+			{"statusCode": http.StatusNotFound, "errorType": "NOT_FOUND"},
+		},
+	}
+}
+
+// mockComponentLinks returns the links of a component with only the GraphQL fields.
+// The caller must hold state.mu.
+func mockComponentLinks(state *mockState, componentID string) []map[string]interface{} {
+	var links []map[string]interface{}
+	for _, l := range state.links {
+		if l["componentId"] == componentID {
+			links = append(links, map[string]interface{}{
+				"id":       l["id"],
+				"name":     l["name"],
+				"type":     l["type"],
+				"url":      l["url"],
+				"objectId": l["objectId"],
+			})
+		}
+	}
+	return links
+}
+
+// mockMatchesFieldFilters applies CompassSearchFilterInput filters on type, ownerId and labels.
+// The mock stores type IDs as "type-<lowercase type>".
+func mockMatchesFieldFilters(comp map[string]interface{}, filters []interface{}) bool {
+	for _, raw := range filters {
+		f, _ := raw.(map[string]interface{})
+		filter, _ := f["filter"].(map[string]interface{})
+		values := map[string]bool{}
+		if eq, ok := filter["eq"].(string); ok {
+			values[eq] = true
+		}
+		in, _ := filter["in"].([]interface{})
+		for _, v := range in {
+			values[v.(string)] = true
+		}
+
+		matched := false
+		switch f["name"] {
+		case "type":
+			typeID, _ := comp["typeId"].(string)
+			matched = values[strings.ToUpper(strings.TrimPrefix(typeID, "type-"))]
+		case "ownerId":
+			ownerID, _ := comp["ownerId"].(string)
+			matched = values[ownerID]
+		case "labels":
+			labels, _ := comp["labels"].([]map[string]interface{})
+			for _, l := range labels {
+				if values[l["name"].(string)] {
+					matched = true
+				}
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// applyMockCustomFields merges CompassCustomFieldInput values into a component the way
+// the API returns them on read. Fields sent with a null value are removed.
+func applyMockCustomFields(comp map[string]interface{}, inputs []interface{}) {
+	typeNames := map[string]string{
+		"booleanField":      "CompassCustomBooleanField",
+		"textField":         "CompassCustomTextField",
+		"numberField":       "CompassCustomNumberField",
+		"singleSelectField": "CompassCustomSingleSelectField",
+		"multiSelectField":  "CompassCustomMultiSelectField",
+		"userField":         "CompassCustomUserField",
+	}
+
+	current := map[string]map[string]interface{}{}
+	if fields, ok := comp["customFields"].([]map[string]interface{}); ok {
+		for _, f := range fields {
+			current[f["definition"].(map[string]interface{})["id"].(string)] = f
+		}
+	}
+
+	for _, raw := range inputs {
+		for inputField, v := range raw.(map[string]interface{}) {
+			value := v.(map[string]interface{})
+			definitionID, _ := value["definitionId"].(string)
+			field := map[string]interface{}{
+				"__typename": typeNames[inputField],
+				"definition": map[string]interface{}{"id": definitionID},
+			}
+			cleared := false
+			switch inputField {
+			case "booleanField":
+				field["booleanValue"] = value["booleanValue"]
+				cleared = value["booleanValue"] == nil
+			case "textField":
+				field["textValue"] = value["textValue"]
+				cleared = value["textValue"] == nil
+			case "numberField":
+				field["numberValue"] = value["numberValue"]
+				cleared = value["numberValue"] == nil
+			case "singleSelectField":
+				field["option"] = map[string]interface{}{"id": value["option"]}
+				cleared = value["option"] == nil
+			case "multiSelectField":
+				var options []map[string]interface{}
+				ids, _ := value["options"].([]interface{})
+				for _, id := range ids {
+					options = append(options, map[string]interface{}{"id": id})
+				}
+				field["options"] = options
+				cleared = value["options"] == nil
+			case "userField":
+				field["userValue"] = map[string]interface{}{"accountId": value["userIdValue"]}
+				cleared = value["userIdValue"] == nil
+			}
+			if cleared {
+				delete(current, definitionID)
+			} else {
+				current[definitionID] = field
+			}
+		}
+	}
+
+	var fields []map[string]interface{}
+	for _, f := range current {
+		fields = append(fields, f)
+	}
+	comp["customFields"] = fields
+}
+
+// mockScorecardCriterion converts a scorecard criteria input into the criterion Compass
+// returns on read, without its ID.
+func mockScorecardCriterion(input map[string]interface{}) map[string]interface{} {
+	typeNames := map[string]string{
+		"hasDescription":        "CompassHasDescriptionScorecardCriteria",
+		"hasOwner":              "CompassHasOwnerScorecardCriteria",
+		"hasLink":               "CompassHasLinkScorecardCriteria",
+		"hasMetricValue":        "CompassHasMetricValueScorecardCriteria",
+		"hasCustomBooleanValue": "CompassHasCustomBooleanFieldScorecardCriteria",
+		"hasCustomTextValue":    "CompassHasCustomTextFieldScorecardCriteria",
+		"hasCustomNumberValue":  "CompassHasCustomNumberFieldScorecardCriteria",
+	}
+
+	criterion := map[string]interface{}{}
+	for member, v := range input {
+		criterion["__typename"] = typeNames[member]
+		for k, value := range v.(map[string]interface{}) {
+			switch k {
+			case "id":
+			case "customFieldDefinitionId":
+				criterion["customFieldDefinition"] = map[string]interface{}{"id": value}
+			default:
+				criterion[k] = value
+			}
+		}
+	}
+	return criterion
+}
+
 func writeJSON(w http.ResponseWriter, status int, v interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	_ = json.NewEncoder(w).Encode(v)
 }
+
+func TestProvider_RetriesRateLimitedRequests(t *testing.T) {
+	state := newMockState()
+	state.rateLimited = 3
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email               = "test@example.com"
+  api_token           = "test-token"
+  base_url            = "%s"
+  tenant              = "temabit"
+  max_retries         = 5
+  retry_max_wait      = 1
+  requests_per_second = 0
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("compass_component.test", "name", "svc-a"),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if state.rateLimited != 0 {
+							return fmt.Errorf("expected all rate limited requests to be retried, %d left", state.rateLimited)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestProviderConfig_GetCloudIDCached(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	compassClient, err := client.NewClient(server.URL, "test@example.com", "test-token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	providerConfig := &ProviderConfig{Client: compassClient, Tenant: "temabit"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cloudID, err := providerConfig.GetCloudID(context.Background())
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if cloudID != "cloud-123" {
+				t.Errorf("expected cloud-123, got %s", cloudID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.tenantLookups != 1 {
+		t.Fatalf("expected 1 tenantContexts query, got %d", state.tenantLookups)
+	}
+}
+
+func TestProviderConfig_ResourceCloudID(t *testing.T) {
+	providerConfig := &ProviderConfig{CloudID: "cloud-123"}
+
+	d := schema.TestResourceDataRaw(t, resourceComponentType().Schema, map[string]interface{}{"name": "Team service"})
+	cloudID, diags := providerConfig.ResourceCloudID(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if cloudID != "cloud-123" || d.Get("cloud_id") != "cloud-123" {
+		t.Errorf("expected cloud_id of the provider to be used and saved, got %s (state %v)", cloudID, d.Get("cloud_id"))
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceComponentType().Schema, map[string]interface{}{"name": "Team service", "cloud_id": "cloud-456"})
+	cloudID, diags = providerConfig.ResourceCloudID(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if cloudID != "cloud-456" {
+		t.Errorf("expected cloud_id of the resource to be used, got %s", cloudID)
+	}
+}
+
+func TestProviderConfig_ResolveComponentTypeCached(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	compassClient, err := client.NewClient(server.URL, "test@example.com", "test-token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	providerConfig := &ProviderConfig{Client: compassClient, Tenant: "temabit"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			componentType, err := providerConfig.ResolveComponentType(context.Background(), "cloud-123", "type-service")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if componentType != "SERVICE" {
+				t.Errorf("expected SERVICE, got %s", componentType)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Custom types resolve to no enum value from the cached built-in types
+	componentType, err := providerConfig.ResolveComponentType(context.Background(), "cloud-123", "type-custom")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if componentType != "" {
+		t.Errorf("expected no enum value for a custom type, got %s", componentType)
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.componentTypeLookups != 1 {
+		t.Fatalf("expected 1 componentTypes query, got %d", state.componentTypeLookups)
+	}
+}
+
+func TestProviderConfig_GetCloudIDNotConfigured(t *testing.T) {
+	providerConfig := &ProviderConfig{}
+
+	_, err := providerConfig.GetCloudID(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "cloud_id is required") {
+		t.Fatalf("expected missing cloud_id error, got %v", err)
+	}
+}
+
+func TestProvider_CloudIDWithoutTenant(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  cloud_id  = "cloud-123"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("compass_component.test", "cloud_id", "cloud-123"),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if state.tenantLookups != 0 {
+							return fmt.Errorf("expected no tenantContexts queries, got %d", state.tenantLookups)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestProvider_BearerAuth(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  auth_method  = "bearer"
+  access_token = "ci-token"
+  base_url     = "%s"
+  cloud_id     = "cloud-123"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("compass_component.test", "name", "svc-a"),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if state.authorization != "Bearer ci-token" {
+							return fmt.Errorf("expected bearer authorization, got %q", state.authorization)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestProvider_OAuthRequiresClientSecret(t *testing.T) {
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := `
+provider "compass" {
+  auth_method = "oauth"
+  client_id   = "service-account"
+  cloud_id    = "cloud-123"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("client_id and client_secret are required"),
+			},
+		},
+	})
+}
+
+func TestProvider_ClientCertRequiresKey(t *testing.T) {
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := `
+provider "compass" {
+  email       = "test@example.com"
+  api_token   = "test-token"
+  cloud_id    = "cloud-123"
+  client_cert = "/etc/ssl/terraform.pem"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("client certificate and client key must be set together"),
+			},
+		},
+	})
+}
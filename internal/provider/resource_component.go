@@ -2,123 +2,73 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-const (
-	createComponentMutation = `
-		mutation CreateComponent($cloudId: ID!, $name: String!, $description: String, $type: CompassComponentType!, $ownerId: ID) {
-			compass {
-				createComponent(
-					cloudId: $cloudId
-					input: {
-						name: $name
-						description: $description
-						type: $type
-						ownerId: $ownerId
-					}
-				) {
-					success
-					componentDetails {
-						id
-						name
-						description
-						typeId
-						ownerId
-					}
-				}
-			}
-		}
-	`
-
-	getComponentQuery = `
-		query GetComponent($id: ID!) {
-			compass {
-				component(id: $id) {
-					... on CompassComponent {
-						id
-						name
-						description
-						typeId
-						ownerId
-					}
-				}
-			}
-		}
-	`
-
-	deleteComponentMutation = `
-		mutation DeleteComponent($input: DeleteCompassComponentInput!) {
-			compass {
-				deleteComponent(input: $input) {
-					success
-				}
-			}
-		}
-	`
-
-	updateComponentMutation = `
-		mutation UpdateComponent($input: UpdateCompassComponentInput!) {
-			compass {
-				updateComponent(input: $input) {
-					success
-					componentDetails {
-						id
-						name
-						description
-						typeId
-						ownerId
-					}
-				}
-			}
-		}
-	`
-)
-
 type Component struct {
-	ID           string                 `json:"id"`
-	Name         string                 `json:"name"`
-	Description  string                 `json:"description"`
-	Type         string                 `json:"type,omitempty"`   // Enum string (SERVICE, LIBRARY, etc.) - used in create
-	TypeID       string                 `json:"typeId,omitempty"` // Type ID returned from API - used in read
-	OwnerID      string                 `json:"ownerId,omitempty"`
-	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+	ID           string               `json:"id"`
+	Name         string               `json:"name"`
+	Slug         string               `json:"slug,omitempty"`
+	Description  string               `json:"description"`
+	Type         string               `json:"type,omitempty"`   // Enum string (SERVICE, LIBRARY, etc.) - used in create
+	TypeID       string               `json:"typeId,omitempty"` // Type ID returned from API - used in read
+	OwnerID      string               `json:"ownerId,omitempty"`
+	Labels       []ComponentLabel     `json:"labels,omitempty"`
+	Links        []ComponentLink      `json:"links,omitempty"`
+	CustomFields []client.CustomField `json:"customFields,omitempty"`
 }
 
-type CreateComponentResponse struct {
-	Compass struct {
-		CreateComponent struct {
-			Success          bool      `json:"success"`
-			ComponentDetails Component `json:"componentDetails"`
-		} `json:"createComponent"`
-	} `json:"compass"`
+type ComponentLabel struct {
+	Name string `json:"name"`
 }
 
-type GetComponentResponse struct {
-	Compass struct {
-		Component Component `json:"component"`
-	} `json:"compass"`
+// QueryError is returned by Compass in place of an object that could not be fetched.
+type QueryError struct {
+	Message    string                  `json:"message"`
+	Extensions []client.ErrorExtension `json:"extensions"`
 }
 
-type DeleteComponentResponse struct {
-	Compass struct {
-		DeleteComponent struct {
-			Success bool `json:"success"`
-		} `json:"deleteComponent"`
-	} `json:"compass"`
+// IsNotFound reports whether the object could not be fetched because it does not exist.
+func (e QueryError) IsNotFound() bool {
+	for _, extension := range e.Extensions {
+		if extension.StatusCode == http.StatusNotFound || strings.Contains(strings.ToUpper(extension.ErrorType), "NOT_FOUND") {
+			return true
+		}
+	}
+	return false
 }
 
-type UpdateComponentResponse struct {
-	Compass struct {
-		UpdateComponent struct {
-			Success          bool      `json:"success"`
-			ComponentDetails Component `json:"componentDetails"`
-		} `json:"updateComponent"`
-	} `json:"compass"`
+// MutationError is an entry of the errors payload Compass returns alongside success=false.
+// It is the type generated for the MutationErrorFields fragment, so the payloads of the
+// generated operations and of the queries in this package are reported the same way.
+type MutationError = client.MutationErrorFields
+
+// mutationError returns the error of a mutation answered with success=false, including the
+// messages of its errors payload so the cause shows up in the diagnostics.
+func mutationError(action string, errors []MutationError) error {
+	if len(errors) == 0 {
+		return fmt.Errorf("failed to %s: GraphQL mutation returned success=false", action)
+	}
+
+	messages := make([]string, 0, len(errors))
+	for _, e := range errors {
+		message := e.Message
+		if e.Extensions.ErrorType != "" || e.Extensions.StatusCode != 0 {
+			message = fmt.Sprintf("%s (errorType: %s, statusCode: %d)", message, e.Extensions.ErrorType, e.Extensions.StatusCode)
+		}
+		messages = append(messages, message)
+	}
+
+	return fmt.Errorf("failed to %s: GraphQL mutation returned success=false: %s", action, strings.Join(messages, "; "))
 }
 
 func resourceComponent() *schema.Resource {
@@ -127,12 +77,13 @@ func resourceComponent() *schema.Resource {
 		ReadContext:   resourceComponentRead,
 		UpdateContext: resourceComponentUpdate,
 		DeleteContext: resourceComponentDelete,
+		CustomizeDiff: resourceComponentCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"cloud_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Cloud ID of the Atlassian site (e.g., jira-12345678-1234-1234-1234-123456789012). If not provided, will be automatically detected from tenant configured in provider.",
+				Description: "Cloud ID of the Atlassian site (e.g., jira-12345678-1234-1234-1234-123456789012). If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -145,19 +96,103 @@ func resourceComponent() *schema.Resource {
 				Description: "Description of the Compass component",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Type of the Compass component. Valid values: SERVICE, LIBRARY, APPLICATION, INFRASTRUCTURE, DATABASE, DOCUMENTATION",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"type", "type_id"},
+				ValidateDiagFunc: validateComponentType,
+				Description:      "Type of the Compass component. Valid values: SERVICE, LIBRARY, APPLICATION, INFRASTRUCTURE, DATABASE, DOCUMENTATION. Conflicts with type_id",
+			},
+			"type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"type", "type_id"},
+				Description:  "ID of the type of the Compass component, e.g. of a custom type managed by compass_component_type. Conflicts with type",
 			},
 			"owner_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Owner ID (Atlassian account ID) of the Compass component",
 			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels attached to the Compass component",
+			},
+			"custom_field": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Custom field values of the Compass component. Exactly the value attribute matching the type must be set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"definition_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the custom field definition",
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateCustomFieldType,
+							Description:      "Type of the custom field. Valid values: BOOLEAN, TEXT, NUMBER, SINGLE_SELECT, MULTI_SELECT, USER",
+						},
+						"boolean_value": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Value of a BOOLEAN custom field",
+						},
+						"text_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of a TEXT custom field",
+						},
+						"number_value": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "Value of a NUMBER custom field",
+						},
+						"single_select_option_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the selected option of a SINGLE_SELECT custom field",
+						},
+						"multi_select_option_ids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the selected options of a MULTI_SELECT custom field",
+						},
+						"user_account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Atlassian account ID of a USER custom field",
+						},
+					},
+				},
+			},
+			"link": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				// Allows link = [] next to the block syntax, so all links can be removed. Without
+				// any link the links of the component are not managed by this resource.
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "Links of the Compass component. When set, links of the component that are not listed here are deleted. Set link = [] to delete all links. Conflicts with compass_component_link and compass_component_links resources for the same component",
+				Elem:        componentLinkResource(),
+				Set:         componentLinkHash,
+			},
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateLinkBlocksRawConfig, validateCustomFieldBlocksRawConfig},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			// Bounds the wait for created links Compass did not return to show up on the component
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+		},
 	}
 }
 
@@ -165,74 +200,64 @@ func resourceComponentCreate(ctx context.Context, d *schema.ResourceData, m inte
 	providerConfig := m.(*ProviderConfig)
 	compassClient := providerConfig.Client
 
-	// Get or auto-detect cloud_id
-	cloudID := ""
-	if v, ok := d.GetOk("cloud_id"); ok && v.(string) != "" {
-		cloudID = v.(string)
-	} else {
-		// Auto-detect cloud_id from tenant
-		if providerConfig.Tenant == "" {
-			return diag.Errorf("cloud_id is required when tenant is not configured in provider")
-		}
-		var err error
-		cloudID, err = compassClient.GetCloudIDByTenant(ctx, providerConfig.Tenant)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to get cloud_id from tenant '%s': %w", providerConfig.Tenant, err))
-		}
-		// Save detected cloud_id to state
-		if err := d.Set("cloud_id", cloudID); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set cloud_id: %w", err))
-		}
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
 	}
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	componentType := d.Get("type").(string)
+	typeID := d.Get("type_id").(string)
 	ownerID := d.Get("owner_id").(string)
 
-	// Validate component type - must be valid CompassComponentType enum value
-	validTypes := map[string]bool{
-		"SERVICE":        true,
-		"LIBRARY":        true,
-		"APPLICATION":    true,
-		"INFRASTRUCTURE": true,
-		"DATABASE":       true,
-		"DOCUMENTATION":  true,
-	}
-	if !validTypes[componentType] {
-		return diag.Errorf("invalid component type: %s. Valid values are: SERVICE, LIBRARY, APPLICATION, INFRASTRUCTURE, DATABASE, DOCUMENTATION", componentType)
-	}
-
-	variables := map[string]interface{}{
-		"cloudId": cloudID,
-		"name":    name,
-		"type":    componentType,
+	input := client.CreateCompassComponentInput{
+		Name:        name,
+		Description: description,
+		OwnerId:     ownerID,
 	}
 
-	if description != "" {
-		variables["description"] = description
+	// Built-in types are passed as enum value, custom types by their ID
+	if typeID != "" {
+		input.TypeId = typeID
+	} else {
+		input.Type = client.CompassComponentType(componentType)
 	}
 
-	if ownerID != "" {
-		variables["ownerId"] = ownerID
+	if v, ok := d.GetOk("custom_field"); ok {
+		customFields, err := expandComponentCustomFields(v.(*schema.Set).List(), false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		input.CustomFields = customFields
 	}
 
-	data, err := compassClient.ExecuteQuery(ctx, createComponentMutation, variables)
+	response, err := client.CreateComponent(ctx, compassClient, cloudID, input)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create component: %w", err))
 	}
 
-	var response CreateComponentResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
+	payload := response.Compass.CreateComponent
+	if !payload.Success {
+		return diag.FromErr(mutationError("create component", payload.Errors))
 	}
 
-	if !response.Compass.CreateComponent.Success {
-		return diag.FromErr(fmt.Errorf("failed to create component: GraphQL mutation returned success=false"))
+	component := payload.ComponentDetails
+	d.SetId(component.Id)
+
+	// Labels are not part of CreateCompassComponentInput, so they are added separately
+	if v, ok := d.GetOk("labels"); ok {
+		if err := addComponentLabels(ctx, compassClient, component.Id, expandStringSet(v.(*schema.Set))); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	component := response.Compass.CreateComponent.ComponentDetails
-	d.SetId(component.ID)
+	// Links are not part of CreateCompassComponentInput either, they are created one by one
+	if v, ok := d.GetOk("link"); ok {
+		if err := applyComponentLinks(ctx, compassClient, component.Id, v.(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceComponentRead(ctx, d, m)
 }
@@ -242,25 +267,13 @@ func resourceComponentRead(ctx context.Context, d *schema.ResourceData, m interf
 	compassClient := providerConfig.Client
 	componentID := d.Id()
 
-	variables := map[string]interface{}{
-		"id": componentID,
-	}
-
-	data, err := compassClient.ExecuteQuery(ctx, getComponentQuery, variables)
+	component, exists, err := getComponent(ctx, compassClient, componentID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read component: %w", err))
 	}
-
-	var response GetComponentResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
-	}
-
-	component := response.Compass.Component
-
-	if component.ID == "" {
-		d.SetId("")
-		return nil
+	if !exists {
+		// Deleted components may be reported as a not-found GraphQL error or QueryError
+		return removeNotFoundFromState(d, "component")
 	}
 
 	// cloud_id is required for creating but not returned in read, so we keep it from state
@@ -269,19 +282,39 @@ func resourceComponentRead(ctx context.Context, d *schema.ResourceData, m interf
 	}
 	d.Set("name", component.Name)
 	d.Set("description", component.Description)
-	// Handle type field - API returns typeId, but we need to preserve the original enum value
-	// Since typeId is an ID (UUID), we keep the original type value from state if available
-	// Otherwise, try to use typeId (though this may not match the enum value)
-	if currentType := d.Get("type"); currentType != nil && currentType.(string) != "" {
-		d.Set("type", currentType.(string))
+	// Handle type field - API returns typeId, which is mapped back to the enum value
+	// through the component type metadata so that import and drift detection work.
+	// Custom types have no enum value, so type is left empty for them.
+	if componentType := componentTypeFromName(component.TypeID); componentType != "" {
+		// Built-in types are usually addressed by their enum value already
+		d.Set("type", componentType)
 	} else if component.TypeID != "" {
-		// If no type in state, try using typeId (may need mapping later)
-		d.Set("type", component.TypeID)
+		cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+		if diags.HasError() {
+			return diags
+		}
+		componentType, err := providerConfig.ResolveComponentType(ctx, cloudID, component.TypeID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("type", componentType)
 	}
+	d.Set("type_id", component.TypeID)
 	// Handle owner field
 	if component.OwnerID != "" {
 		d.Set("owner_id", component.OwnerID)
 	}
+	labels := make([]string, 0, len(component.Labels))
+	for _, label := range component.Labels {
+		labels = append(labels, label.Name)
+	}
+	d.Set("labels", labels)
+	if err := d.Set("custom_field", flattenComponentCustomFields(component.CustomFields)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set custom_field: %w", err))
+	}
+	if err := d.Set("link", flattenComponentLinks(component.Links)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set link: %w", err))
+	}
 
 	return nil
 }
@@ -296,92 +329,443 @@ func resourceComponentUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.Errorf("cloud_id cannot be changed. Please delete and recreate the component with the new cloud_id.")
 	}
 
-	// type cannot be changed via updateComponent mutation (not in UpdateCompassComponentInput)
-	if d.HasChange("type") {
-		return diag.Errorf("type cannot be changed. Please delete and recreate the component with the new type.")
+	// type is not part of UpdateCompassComponentInput, it is changed through a separate mutation.
+	// It goes first, so custom fields that only apply to the new type can be set below.
+	if d.HasChanges("type", "type_id") {
+		input := client.UpdateCompassComponentTypeInput{
+			Id: componentID,
+		}
+
+		// The attribute that is not configured is unknown during apply and reads as empty
+		if typeID := d.Get("type_id").(string); d.HasChange("type_id") && typeID != "" {
+			input.TypeId = typeID
+		} else {
+			input.Type = client.CompassComponentType(d.Get("type").(string))
+		}
+
+		response, err := client.UpdateComponentType(ctx, compassClient, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update component type: %w", err))
+		}
+
+		if !response.Compass.UpdateComponentType.Success {
+			return diag.FromErr(mutationError("update component type", response.Compass.UpdateComponentType.Errors))
+		}
+	}
+
+	// Labels are managed through separate mutations, only sending what was added or removed
+	if d.HasChange("labels") {
+		oldLabels, newLabels := d.GetChange("labels")
+		toRemove := oldLabels.(*schema.Set).Difference(newLabels.(*schema.Set))
+		toAdd := newLabels.(*schema.Set).Difference(oldLabels.(*schema.Set))
+
+		if toRemove.Len() > 0 {
+			if err := removeComponentLabels(ctx, compassClient, componentID, expandStringSet(toRemove)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if toAdd.Len() > 0 {
+			if err := addComponentLabels(ctx, compassClient, componentID, expandStringSet(toAdd)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	// Links are reconciled with the links of the component, links not configured are deleted
+	if d.HasChange("link") {
+		if err := applyComponentLinks(ctx, compassClient, componentID, d.Get("link").(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	// Check if any updatable fields have changed
-	if !d.HasChanges("name", "description", "owner_id") {
+	if !d.HasChanges("name", "description", "owner_id", "custom_field") {
 		// No changes to updatable fields, just read the state
 		return resourceComponentRead(ctx, d, m)
 	}
 
 	// Build update input
-	input := map[string]interface{}{
-		"id": componentID,
+	input := client.UpdateCompassComponentInput{
+		Id: componentID,
 	}
 
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		input["name"] = name
+		input.Name = &name
 	}
 
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
 		// Include description even if empty to allow clearing it
-		input["description"] = description
+		input.Description = &description
 	}
 
-	if d.HasChange("owner_id") {
-		ownerID := d.Get("owner_id").(string)
-		// Include ownerId even if empty to allow clearing it
-		if ownerID != "" {
-			input["ownerId"] = ownerID
-		} else {
-			// For clearing owner, we might need to pass null explicitly
-			// Try passing empty string first, API should handle it
-			input["ownerId"] = nil
-		}
+	// ownerId is always sent, an empty owner_id is sent as null to clear the owner
+	if ownerID := d.Get("owner_id").(string); ownerID != "" {
+		input.OwnerId = &ownerID
 	}
 
-	variables := map[string]interface{}{
-		"input": input,
+	if d.HasChange("custom_field") {
+		oldFields, newFields := d.GetChange("custom_field")
+		customFields, err := expandComponentCustomFields(newFields.(*schema.Set).List(), false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Fields removed from the configuration are cleared by sending them without a value
+		configured := map[string]bool{}
+		for _, raw := range newFields.(*schema.Set).List() {
+			configured[raw.(map[string]interface{})["definition_id"].(string)] = true
+		}
+		var removed []interface{}
+		for _, raw := range oldFields.(*schema.Set).List() {
+			if !configured[raw.(map[string]interface{})["definition_id"].(string)] {
+				removed = append(removed, raw)
+			}
+		}
+		cleared, err := expandComponentCustomFields(removed, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.CustomFields = append(customFields, cleared...)
 	}
 
-	data, err := compassClient.ExecuteQuery(ctx, updateComponentMutation, variables)
+	response, err := client.UpdateComponent(ctx, compassClient, input)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update component: %w", err))
 	}
 
-	var response UpdateComponentResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
-	}
-
 	if !response.Compass.UpdateComponent.Success {
-		return diag.FromErr(fmt.Errorf("failed to update component: GraphQL mutation returned success=false"))
+		return diag.FromErr(mutationError("update component", response.Compass.UpdateComponent.Errors))
 	}
 
 	// Update successful, read the latest state
 	return resourceComponentRead(ctx, d, m)
 }
 
+// resourceComponentCustomizeDiff marks the other type attribute as unknown when type or type_id
+// changes, since both are updated together from the typeId returned by Compass. It also warns
+// about links that will be deleted because they are not listed in the link blocks.
+func resourceComponentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	warnDeletedComponentLinks(ctx, d)
+	if d.HasChange("type") && !d.HasChange("type_id") {
+		return d.SetNewComputed("type_id")
+	}
+	if d.HasChange("type_id") && !d.HasChange("type") {
+		return d.SetNewComputed("type")
+	}
+	return nil
+}
+
+// warnDeletedComponentLinks logs a warning for the links of the component that the configured
+// link blocks delete. They may be managed by compass_component_link or compass_component_links
+// resources, which then recreate them on every apply. The SDK cannot return warnings from
+// CustomizeDiff, so the warning is only logged.
+func warnDeletedComponentLinks(ctx context.Context, d *schema.ResourceDiff) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || rawConfig.GetAttr("link").IsNull() || !d.HasChange("link") {
+		return
+	}
+
+	oldLinks, newLinks := d.GetChange("link")
+	names := map[string]bool{}
+	for _, raw := range newLinks.(*schema.Set).List() {
+		names[raw.(map[string]interface{})["name"].(string)] = true
+	}
+	var deleted []string
+	for _, raw := range oldLinks.(*schema.Set).List() {
+		if name := raw.(map[string]interface{})["name"].(string); !names[name] {
+			deleted = append(deleted, name)
+		}
+	}
+
+	if len(deleted) > 0 {
+		tflog.Warn(ctx, "Links of the component that are not listed in its link blocks will be deleted. Links managed by compass_component_link or compass_component_links resources for the same component conflict with the link blocks", map[string]interface{}{
+			"component_id": d.Id(),
+			"links":        deleted,
+		})
+	}
+}
+
 func resourceComponentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConfig := m.(*ProviderConfig)
 	compassClient := providerConfig.Client
 	componentID := d.Id()
 
-	variables := map[string]interface{}{
-		"input": map[string]interface{}{
-			"id": componentID,
-		},
+	response, err := client.DeleteComponent(ctx, compassClient, client.DeleteCompassComponentInput{
+		Id: componentID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete component: %w", err))
 	}
 
-	data, err := compassClient.ExecuteQuery(ctx, deleteComponentMutation, variables)
+	if !response.Compass.DeleteComponent.Success {
+		return diag.FromErr(mutationError("delete component", response.Compass.DeleteComponent.Errors))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func addComponentLabels(ctx context.Context, compassClient *client.Client, componentID string, labels []string) error {
+	response, err := client.AddComponentLabels(ctx, compassClient, client.AddCompassComponentLabelsInput{
+		ComponentId: componentID,
+		LabelNames:  labels,
+	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete component: %w", err))
+		return fmt.Errorf("failed to add component labels: %w", err)
 	}
 
-	var response DeleteComponentResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
+	if !response.Compass.AddComponentLabels.Success {
+		return mutationError("add component labels", response.Compass.AddComponentLabels.Errors)
 	}
 
-	if !response.Compass.DeleteComponent.Success {
-		return diag.FromErr(fmt.Errorf("failed to delete component: GraphQL mutation returned success=false"))
+	return nil
+}
+
+func removeComponentLabels(ctx context.Context, compassClient *client.Client, componentID string, labels []string) error {
+	response, err := client.RemoveComponentLabels(ctx, compassClient, client.RemoveCompassComponentLabelsInput{
+		ComponentId: componentID,
+		LabelNames:  labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove component labels: %w", err)
+	}
+
+	if !response.Compass.RemoveComponentLabels.Success {
+		return mutationError("remove component labels", response.Compass.RemoveComponentLabels.Errors)
 	}
 
-	d.SetId("")
 	return nil
 }
+
+// getComponent fetches a component through the generated GetComponent query. It reports
+// whether the component exists, so callers can tell deleted components from failed reads.
+func getComponent(ctx context.Context, compassClient *client.Client, componentID string) (Component, bool, error) {
+	response, err := client.GetComponent(ctx, compassClient, componentID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return Component{}, false, nil
+		}
+		return Component{}, false, err
+	}
+
+	switch component := response.Compass.Component.(type) {
+	case *client.GetComponentCompassCompassCatalogQueryApiComponentCompassComponent:
+		labels := make([]ComponentLabel, 0, len(component.Labels))
+		for _, label := range component.Labels {
+			labels = append(labels, ComponentLabel{Name: label.Name})
+		}
+		return Component{
+			ID:           component.Id,
+			Name:         component.Name,
+			Slug:         component.Slug,
+			Description:  component.Description,
+			TypeID:       component.TypeId,
+			OwnerID:      component.OwnerId,
+			Labels:       labels,
+			Links:        componentLinksFromFields(component.Links),
+			CustomFields: component.CustomFields,
+		}, true, nil
+	case *client.GetComponentCompassCompassCatalogQueryApiComponentQueryError:
+		if (QueryError{Message: component.Message, Extensions: component.Extensions}).IsNotFound() {
+			return Component{}, false, nil
+		}
+		return Component{}, false, fmt.Errorf("%s", component.Message)
+	case nil:
+		return Component{}, false, nil
+	default:
+		return Component{}, false, fmt.Errorf("unexpected component result %T", component)
+	}
+}
+
+// removeNotFoundFromState removes a resource that no longer exists in Compass from state,
+// with a warning, so it is recreated on the next apply instead of failing the plan.
+func removeNotFoundFromState(d *schema.ResourceData, kind string) diag.Diagnostics {
+	id := d.Id()
+	d.SetId("")
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Compass %s not found", kind),
+			Detail:   fmt.Sprintf("The %s %s no longer exists in Compass, it was probably deleted outside of Terraform. It has been removed from state and will be recreated on the next apply.", kind, id),
+		},
+	}
+}
+
+// expandStringSet converts a set of strings from the schema into a slice.
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(string))
+	}
+	return values
+}
+
+// customFieldTypes maps the custom_field type values to the block attribute holding the value,
+// the CompassCustomFieldInput member and value field used to write them and the CompassCustomField
+// union member returned on read.
+var customFieldTypes = map[string]struct {
+	attribute  string
+	inputField string
+	valueField string
+	typeName   string
+}{
+	"BOOLEAN":       {attribute: "boolean_value", inputField: "booleanField", valueField: "booleanValue", typeName: "CompassCustomBooleanField"},
+	"TEXT":          {attribute: "text_value", inputField: "textField", valueField: "textValue", typeName: "CompassCustomTextField"},
+	"NUMBER":        {attribute: "number_value", inputField: "numberField", valueField: "numberValue", typeName: "CompassCustomNumberField"},
+	"SINGLE_SELECT": {attribute: "single_select_option_id", inputField: "singleSelectField", valueField: "option", typeName: "CompassCustomSingleSelectField"},
+	"MULTI_SELECT":  {attribute: "multi_select_option_ids", inputField: "multiSelectField", valueField: "options", typeName: "CompassCustomMultiSelectField"},
+	"USER":          {attribute: "user_account_id", inputField: "userField", valueField: "userIdValue", typeName: "CompassCustomUserField"},
+}
+
+// expandComponentCustomFields builds the CompassCustomFieldInput list from custom_field blocks.
+// When clear is set, the values are sent as null to unset the fields on the component.
+// Otherwise the value attribute matching the type must be set, which validateCustomFieldBlocksRawConfig
+// already checks at plan time.
+func expandComponentCustomFields(raw []interface{}, clear bool) ([]map[string]interface{}, error) {
+	customFields := make([]map[string]interface{}, 0, len(raw))
+	for _, r := range raw {
+		field := r.(map[string]interface{})
+		definitionID := field["definition_id"].(string)
+		fieldType := field["type"].(string)
+
+		mapping, ok := customFieldTypes[fieldType]
+		if !ok {
+			return nil, invalidCustomFieldTypeError(fieldType)
+		}
+
+		var fieldValue interface{}
+		if !clear {
+			switch fieldType {
+			case "BOOLEAN":
+				fieldValue = field["boolean_value"].(bool)
+			case "NUMBER":
+				fieldValue = field["number_value"].(float64)
+			case "MULTI_SELECT":
+				options := expandStringSet(field["multi_select_option_ids"].(*schema.Set))
+				if len(options) == 0 {
+					return nil, missingCustomFieldValueError(definitionID, fieldType)
+				}
+				fieldValue = options
+			default:
+				value := field[mapping.attribute].(string)
+				if value == "" {
+					return nil, missingCustomFieldValueError(definitionID, fieldType)
+				}
+				fieldValue = value
+			}
+		}
+
+		customFields = append(customFields, map[string]interface{}{
+			mapping.inputField: map[string]interface{}{
+				"definitionId":     definitionID,
+				mapping.valueField: fieldValue,
+			},
+		})
+	}
+	return customFields, nil
+}
+
+// flattenComponentCustomFields converts custom fields returned by the API into custom_field blocks.
+// Fields without a value are skipped so unset definitions do not show up as drift.
+func flattenComponentCustomFields(customFields []client.CustomField) []interface{} {
+	result := make([]interface{}, 0, len(customFields))
+	for _, customField := range customFields {
+		field := map[string]interface{}{
+			"definition_id": customField.Definition.ID,
+		}
+
+		switch customField.TypeName {
+		case customFieldTypes["BOOLEAN"].typeName:
+			if customField.BooleanValue == nil {
+				continue
+			}
+			field["type"] = "BOOLEAN"
+			field["boolean_value"] = *customField.BooleanValue
+		case customFieldTypes["TEXT"].typeName:
+			if customField.TextValue == nil {
+				continue
+			}
+			field["type"] = "TEXT"
+			field["text_value"] = *customField.TextValue
+		case customFieldTypes["NUMBER"].typeName:
+			if customField.NumberValue == nil {
+				continue
+			}
+			field["type"] = "NUMBER"
+			field["number_value"] = *customField.NumberValue
+		case customFieldTypes["SINGLE_SELECT"].typeName:
+			if customField.Option == nil {
+				continue
+			}
+			field["type"] = "SINGLE_SELECT"
+			field["single_select_option_id"] = customField.Option.ID
+		case customFieldTypes["MULTI_SELECT"].typeName:
+			if len(customField.Options) == 0 {
+				continue
+			}
+			optionIDs := make([]interface{}, 0, len(customField.Options))
+			for _, option := range customField.Options {
+				optionIDs = append(optionIDs, option.ID)
+			}
+			field["type"] = "MULTI_SELECT"
+			field["multi_select_option_ids"] = schema.NewSet(schema.HashString, optionIDs)
+		case customFieldTypes["USER"].typeName:
+			if customField.UserValue == nil {
+				continue
+			}
+			field["type"] = "USER"
+			field["user_account_id"] = customField.UserValue.AccountID
+		default:
+			continue
+		}
+
+		result = append(result, field)
+	}
+	return result
+}
+
+// getComponentTypes returns all component types (built-in and custom) of the site.
+func getComponentTypes(ctx context.Context, compassClient *client.Client, cloudID string) ([]client.ComponentTypeFields, error) {
+	var componentTypes []client.ComponentTypeFields
+
+	err := client.Paginate(ctx, func(ctx context.Context, cursor string) (client.PageInfo, error) {
+		response, err := client.GetComponentTypes(ctx, compassClient, cloudID, client.CompassComponentTypeQueryInput{
+			First: 50,
+			After: cursor,
+		})
+		if err != nil {
+			return client.PageInfo{}, fmt.Errorf("failed to read component types: %w", err)
+		}
+
+		switch result := response.Compass.ComponentTypes.(type) {
+		case *client.GetComponentTypesCompassCompassCatalogQueryApiComponentTypesCompassComponentTypeConnection:
+			componentTypes = append(componentTypes, result.Nodes...)
+			return result.PageInfo, nil
+		case *client.GetComponentTypesCompassCompassCatalogQueryApiComponentTypesQueryError:
+			return client.PageInfo{}, fmt.Errorf("failed to read component types: %s", result.Message)
+		default:
+			return client.PageInfo{}, fmt.Errorf("unexpected component types result %T", result)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return componentTypes, nil
+}
+
+// componentTypeFromName converts a component type name (e.g. "Service" or "SERVICE") to
+// the CompassComponentType enum value, or returns an empty string for other types.
+func componentTypeFromName(name string) string {
+	componentType := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+	if !slices.Contains(componentTypeValues, componentType) {
+		return ""
+	}
+	return componentType
+}
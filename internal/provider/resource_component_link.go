@@ -2,63 +2,15 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-const (
-	createComponentLinkMutation = `
-		mutation CreateComponentLink($input: CreateCompassComponentLinkInput!) {
-			compass {
-				createComponentLink(input: $input) {
-					success
-				}
-			}
-		}
-	`
-
-	getComponentLinkQuery = `
-		query GetComponentLink($cloudId: ID!, $componentId: ID!, $linkId: ID!) {
-			compass {
-				component(id: $componentId) {
-					... on CompassComponent {
-						links {
-							id
-							name
-							type
-							url
-							objectId
-						}
-					}
-				}
-			}
-		}
-	`
-
-	updateComponentLinkMutation = `
-		mutation UpdateComponentLink($input: UpdateCompassComponentLinkInput!) {
-			compass {
-				updateComponentLink(input: $input) {
-					success
-				}
-			}
-		}
-	`
-
-	deleteComponentLinkMutation = `
-		mutation DeleteComponentLink($input: DeleteCompassComponentLinkInput!) {
-			compass {
-				deleteComponentLink(input: $input) {
-					success
-				}
-			}
-		}
-	`
-)
-
 type ComponentLink struct {
 	ID       string `json:"id"`
 	Name     string `json:"name"`
@@ -67,38 +19,6 @@ type ComponentLink struct {
 	ObjectID string `json:"objectId,omitempty"`
 }
 
-type CreateComponentLinkResponse struct {
-	Compass struct {
-		CreateComponentLink struct {
-			Success bool `json:"success"`
-		} `json:"createComponentLink"`
-	} `json:"compass"`
-}
-
-type GetComponentResponseWithLinks struct {
-	Compass struct {
-		Component struct {
-			Links []ComponentLink `json:"links"`
-		} `json:"component"`
-	} `json:"compass"`
-}
-
-type UpdateComponentLinkResponse struct {
-	Compass struct {
-		UpdateComponentLink struct {
-			Success bool `json:"success"`
-		} `json:"updateComponentLink"`
-	} `json:"compass"`
-}
-
-type DeleteComponentLinkResponse struct {
-	Compass struct {
-		DeleteComponentLink struct {
-			Success bool `json:"success"`
-		} `json:"deleteComponentLink"`
-	} `json:"compass"`
-}
-
 func resourceComponentLink() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceComponentLinkCreate,
@@ -117,7 +37,7 @@ func resourceComponentLink() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				ForceNew:    true,
-				Description: "Cloud ID of the Atlassian site. If not provided, will be automatically detected from tenant configured in provider.",
+				Description: "Cloud ID of the Atlassian site. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -125,14 +45,16 @@ func resourceComponentLink() *schema.Resource {
 				Description: "Name of the link",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Type of the link. Valid values: DOCUMENT, CHAT_CHANNEL, REPOSITORY, PROJECT, DASHBOARD, ON_CALL, OTHER_LINK",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateLinkType,
+				Description:      "Type of the link. Valid values: DOCUMENT, CHAT_CHANNEL, REPOSITORY, PROJECT, DASHBOARD, ON_CALL, OTHER_LINK. CHAT_CHANNEL links must point to Slack or Microsoft Teams, REPOSITORY links to a git host",
 			},
 			"url": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "URL of the link",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateLinkURL,
+				Description:      "URL of the link",
 			},
 			"object_id": {
 				Type:        schema.TypeString,
@@ -140,9 +62,14 @@ func resourceComponentLink() *schema.Resource {
 				Description: "The unique ID of the object the link points to (generally configured by integrations)",
 			},
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateComponentLinkRawConfig},
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceComponentLinkImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			// Bounds the wait for a created link Compass did not return to show up on its component
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
 	}
 }
 
@@ -152,24 +79,8 @@ func resourceComponentLinkCreate(ctx context.Context, d *schema.ResourceData, m
 
 	componentID := d.Get("component_id").(string)
 
-	// Get or auto-detect cloud_id
-	cloudID := ""
-	if v, ok := d.GetOk("cloud_id"); ok && v.(string) != "" {
-		cloudID = v.(string)
-	} else {
-		// Auto-detect cloud_id from tenant
-		if providerConfig.Tenant == "" {
-			return diag.Errorf("cloud_id is required when tenant is not configured in provider")
-		}
-		var err error
-		cloudID, err = compassClient.GetCloudIDByTenant(ctx, providerConfig.Tenant)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to get cloud_id from tenant '%s': %w", providerConfig.Tenant, err))
-		}
-		// Save detected cloud_id to state
-		if err := d.Set("cloud_id", cloudID); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set cloud_id: %w", err))
-		}
+	if _, diags := providerConfig.ResourceCloudID(ctx, d); diags.HasError() {
+		return diags
 	}
 
 	name := d.Get("name").(string)
@@ -177,119 +88,17 @@ func resourceComponentLinkCreate(ctx context.Context, d *schema.ResourceData, m
 	url := d.Get("url").(string)
 	objectID := d.Get("object_id").(string)
 
-	// Validate link type
-	validTypes := map[string]bool{
-		"DOCUMENT":     true,
-		"CHAT_CHANNEL": true,
-		"REPOSITORY":   true,
-		"PROJECT":      true,
-		"DASHBOARD":    true,
-		"ON_CALL":      true,
-		"OTHER_LINK":   true,
-	}
-	if !validTypes[linkType] {
-		return diag.Errorf("invalid link type: %s. Valid values are: DOCUMENT, CHAT_CHANNEL, REPOSITORY, PROJECT, DASHBOARD, ON_CALL, OTHER_LINK", linkType)
-	}
-
-	// Build input according to CreateCompassComponentLinkInput structure:
-	// - componentId: ID!
-	// - link: CreateCompassLinkInput! (contains name, type, url, objectId)
-	linkInput := map[string]interface{}{
-		"name": name,
-		"type": linkType,
-		"url":  url,
-	}
-
-	if objectID != "" {
-		linkInput["objectId"] = objectID
-	}
-
-	// Build full input: CreateCompassComponentLinkInput
-	// Note: cloudId might need to be included in the input, or componentId might contain it
-	// Based on error, cloudId is not a separate parameter to the mutation
-	createInput := map[string]interface{}{
-		"componentId": componentID,
-		"link":        linkInput,
-	}
-
-	variables := map[string]interface{}{
-		"input": createInput,
-	}
-
-	data, err := compassClient.ExecuteQuery(ctx, createComponentLinkMutation, variables)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create component link: %w", err))
-	}
-
-	var response CreateComponentLinkResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
-	}
-
-	if !response.Compass.CreateComponentLink.Success {
-		return diag.FromErr(fmt.Errorf("failed to create component link: GraphQL mutation returned success=false"))
-	}
-
-	// The mutation doesn't return the link ID, so we need to read it from the component
-	// We'll use a temporary ID and then read to get the actual ID
-	// Alternatively, we can query the component links to find the newly created link
-	// by matching name, type, and url
-
-	// Query component links to find the created link
-	getComponentQueryTemp := `
-		query GetComponent($componentId: ID!) {
-			compass {
-				component(id: $componentId) {
-					... on CompassComponent {
-						id
-						links {
-							id
-							name
-							type
-							url
-							objectId
-						}
-					}
-				}
-			}
-		}
-	`
-
-	variablesRead := map[string]interface{}{
-		"componentId": componentID,
-	}
-
-	dataRead, err := compassClient.ExecuteQuery(ctx, getComponentQueryTemp, variablesRead)
+	linkID, err := createComponentLink(ctx, compassClient, componentID, client.CreateCompassLinkInput{
+		Name:     name,
+		Type:     client.CompassLinkType(linkType),
+		Url:      url,
+		ObjectId: objectID,
+	}, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read component links after creation: %w", err))
-	}
-
-	var responseRead GetComponentResponseWithLinks
-	if err := json.Unmarshal(dataRead, &responseRead); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal component links response: %w", err))
-	}
-
-	// Find the link by matching name, type, and url (since we don't have ID yet)
-	var foundLink *ComponentLink
-	for i := range responseRead.Compass.Component.Links {
-		link := responseRead.Compass.Component.Links[i]
-		if link.Name == name && link.Type == linkType && link.URL == url {
-			// Also check objectId if provided
-			if objectID == "" && link.ObjectID == "" {
-				foundLink = &link
-				break
-			} else if objectID != "" && link.ObjectID == objectID {
-				foundLink = &link
-				break
-			}
-		}
-	}
-
-	if foundLink == nil {
-		return diag.Errorf("failed to find created link in component. Created link may not be visible yet.")
+		return diag.FromErr(err)
 	}
 
-	d.SetId(foundLink.ID)
+	d.SetId(linkID)
 
 	return resourceComponentLinkRead(ctx, d, m)
 }
@@ -301,80 +110,42 @@ func resourceComponentLinkRead(ctx context.Context, d *schema.ResourceData, m in
 	linkID := d.Id()
 	componentID := d.Get("component_id").(string)
 
-	// Get or auto-detect cloud_id
-	cloudID := ""
-	if v, ok := d.GetOk("cloud_id"); ok && v.(string) != "" {
-		cloudID = v.(string)
-	} else {
-		// Auto-detect cloud_id from tenant
-		if providerConfig.Tenant == "" {
-			return diag.Errorf("cloud_id is required when tenant is not configured in provider")
-		}
-		var err error
-		cloudID, err = compassClient.GetCloudIDByTenant(ctx, providerConfig.Tenant)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to get cloud_id from tenant '%s': %w", providerConfig.Tenant, err))
-		}
-	}
-
-	// Query component to get all links
-	// Note: We need to find the specific link by ID from the component's links
-	getComponentQuery := `
-		query GetComponent($componentId: ID!) {
-			compass {
-				component(id: $componentId) {
-					... on CompassComponent {
-						id
-						links {
-							id
-							name
-							type
-							url
-							objectId
-						}
-					}
-				}
-			}
-		}
-	`
-
-	variables := map[string]interface{}{
-		"componentId": componentID,
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
 	}
 
-	data, err := compassClient.ExecuteQuery(ctx, getComponentQuery, variables)
+	links, exists, err := getComponentLinks(ctx, compassClient, componentID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read component link: %w", err))
 	}
-
-	var response GetComponentResponseWithLinks
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
+	if !exists {
+		// The link is gone together with its component
+		return removeNotFoundFromState(d, "component link")
 	}
 
 	// Find the specific link by ID
-	var foundLink *ComponentLink
-	for _, link := range response.Compass.Component.Links {
-		if link.ID == linkID {
-			foundLink = &link
+	var foundLink *client.ComponentLinkFields
+	for i := range links {
+		if links[i].Id == linkID {
+			foundLink = &links[i]
 			break
 		}
 	}
 
 	if foundLink == nil {
 		// Link not found, mark as deleted
-		d.SetId("")
-		return nil
+		return removeNotFoundFromState(d, "component link")
 	}
 
 	// Set fields
 	d.Set("component_id", componentID)
 	d.Set("cloud_id", cloudID)
 	d.Set("name", foundLink.Name)
-	d.Set("type", foundLink.Type)
-	d.Set("url", foundLink.URL)
-	if foundLink.ObjectID != "" {
-		d.Set("object_id", foundLink.ObjectID)
+	d.Set("type", string(foundLink.Type))
+	d.Set("url", foundLink.Url)
+	if foundLink.ObjectId != "" {
+		d.Set("object_id", foundLink.ObjectId)
 	}
 
 	return nil
@@ -393,73 +164,33 @@ func resourceComponentLinkUpdate(ctx context.Context, d *schema.ResourceData, m
 		return resourceComponentLinkRead(ctx, d, m)
 	}
 
-	// Build update input according to UpdateCompassComponentLinkInput structure:
-	// - componentId: ID!
-	// - link: UpdateCompassLinkInput! (contains id (required), name, type, url, objectId - all optional)
-	// Only include fields that have changed
-	linkInput := map[string]interface{}{
-		"id": linkID,
+	// Only the fields that have changed are sent, except objectId which is always sent:
+	// it has to be null to clear it, so it can't be left out
+	linkInput := client.UpdateCompassLinkInput{
+		Id: linkID,
 	}
 
-	// Only add fields that have actually changed
 	if d.HasChange("name") {
-		linkInput["name"] = d.Get("name").(string)
+		name := d.Get("name").(string)
+		linkInput.Name = &name
 	}
 
 	if d.HasChange("type") {
-		linkType := d.Get("type").(string)
-		// Validate link type
-		validTypes := map[string]bool{
-			"DOCUMENT":     true,
-			"CHAT_CHANNEL": true,
-			"REPOSITORY":   true,
-			"PROJECT":      true,
-			"DASHBOARD":    true,
-			"ON_CALL":      true,
-			"OTHER_LINK":   true,
-		}
-		if !validTypes[linkType] {
-			return diag.Errorf("invalid link type: %s. Valid values are: DOCUMENT, CHAT_CHANNEL, REPOSITORY, PROJECT, DASHBOARD, ON_CALL, OTHER_LINK", linkType)
-		}
-		linkInput["type"] = linkType
+		compassLinkType := client.CompassLinkType(d.Get("type").(string))
+		linkInput.Type = &compassLinkType
 	}
 
 	if d.HasChange("url") {
-		linkInput["url"] = d.Get("url").(string)
-	}
-
-	if d.HasChange("object_id") {
-		objectID := d.Get("object_id").(string)
-		if objectID != "" {
-			linkInput["objectId"] = objectID
-		} else {
-			// For clearing objectId, we might need to pass null explicitly
-			linkInput["objectId"] = nil
-		}
+		url := d.Get("url").(string)
+		linkInput.Url = &url
 	}
 
-	// Build full input: UpdateCompassComponentLinkInput
-	updateInput := map[string]interface{}{
-		"componentId": componentID,
-		"link":        linkInput,
+	if objectID := d.Get("object_id").(string); objectID != "" {
+		linkInput.ObjectId = &objectID
 	}
 
-	variables := map[string]interface{}{
-		"input": updateInput,
-	}
-
-	data, err := compassClient.ExecuteQuery(ctx, updateComponentLinkMutation, variables)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update component link: %w", err))
-	}
-
-	var response UpdateComponentLinkResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
-	}
-
-	if !response.Compass.UpdateComponentLink.Success {
-		return diag.FromErr(fmt.Errorf("failed to update component link: GraphQL mutation returned success=false"))
+	if err := updateComponentLink(ctx, compassClient, componentID, linkInput); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Update successful, read the latest state
@@ -473,36 +204,121 @@ func resourceComponentLinkDelete(ctx context.Context, d *schema.ResourceData, m
 	linkID := d.Id()
 	componentID := d.Get("component_id").(string)
 
-	// Build delete input according to DeleteCompassComponentLinkInput structure:
-	// - componentId: ID!
-	// - link: ID!
-	deleteInput := map[string]interface{}{
-		"componentId": componentID,
-		"link":        linkID,
+	if err := deleteComponentLink(ctx, compassClient, componentID, linkID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// createComponentLink creates a link on a component and returns its ID. The ID is taken from
+// the createdComponentLink payload. When Compass does not return it, the link is looked up
+// among the links of the component by its fields until it is visible or timeout expires.
+func createComponentLink(ctx context.Context, compassClient *client.Client, componentID string, link client.CreateCompassLinkInput, timeout time.Duration) (string, error) {
+	response, err := client.CreateComponentLink(ctx, compassClient, client.CreateCompassComponentLinkInput{
+		ComponentId: componentID,
+		Link:        link,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create component link: %w", err)
+	}
+
+	payload := response.Compass.CreateComponentLink
+	if !payload.Success {
+		return "", mutationError("create component link", payload.Errors)
 	}
 
-	variables := map[string]interface{}{
-		"input": deleteInput,
+	if payload.CreatedComponentLink != nil && payload.CreatedComponentLink.Id != "" {
+		return payload.CreatedComponentLink.Id, nil
 	}
 
-	data, err := compassClient.ExecuteQuery(ctx, deleteComponentLinkMutation, variables)
+	// Query component links to find the created link by matching name, type, url and objectId.
+	// A new link may not be listed right away, so the lookup is repeated until it shows up.
+	var linkID string
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		links, exists, err := getComponentLinks(ctx, compassClient, componentID)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read component links after creation: %w", err))
+		}
+		if !exists {
+			return retry.NonRetryableError(fmt.Errorf("failed to read component links after creation: component %s not found", componentID))
+		}
+
+		for _, l := range links {
+			if l.Name == link.Name && l.Type == link.Type && l.Url == link.Url && l.ObjectId == link.ObjectId {
+				linkID = l.Id
+				return nil
+			}
+		}
+
+		return retry.RetryableError(fmt.Errorf("failed to find created link in component. Created link may not be visible yet."))
+	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete component link: %w", err))
+		return "", err
+	}
+
+	return linkID, nil
+}
+
+// updateComponentLink updates a link of a component. Fields of link left nil are not changed,
+// except objectId which is cleared.
+func updateComponentLink(ctx context.Context, compassClient *client.Client, componentID string, link client.UpdateCompassLinkInput) error {
+	response, err := client.UpdateComponentLink(ctx, compassClient, client.UpdateCompassComponentLinkInput{
+		ComponentId: componentID,
+		Link:        link,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update component link: %w", err)
+	}
+
+	if !response.Compass.UpdateComponentLink.Success {
+		return mutationError("update component link", response.Compass.UpdateComponentLink.Errors)
 	}
 
-	var response DeleteComponentLinkResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to unmarshal response: %w", err))
+	return nil
+}
+
+// deleteComponentLink deletes a link of a component.
+func deleteComponentLink(ctx context.Context, compassClient *client.Client, componentID, linkID string) error {
+	response, err := client.DeleteComponentLink(ctx, compassClient, client.DeleteCompassComponentLinkInput{
+		ComponentId: componentID,
+		Link:        linkID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete component link: %w", err)
 	}
 
 	if !response.Compass.DeleteComponentLink.Success {
-		return diag.FromErr(fmt.Errorf("failed to delete component link: GraphQL mutation returned success=false"))
+		return mutationError("delete component link", response.Compass.DeleteComponentLink.Errors)
 	}
 
-	d.SetId("")
 	return nil
 }
 
+// getComponentLinks returns the links of a component, and false when the component does not exist.
+func getComponentLinks(ctx context.Context, compassClient *client.Client, componentID string) ([]client.ComponentLinkFields, bool, error) {
+	response, err := client.GetComponentLinks(ctx, compassClient, componentID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	switch component := response.Compass.Component.(type) {
+	case *client.GetComponentLinksCompassCompassCatalogQueryApiComponentCompassComponent:
+		return component.Links, true, nil
+	case *client.GetComponentLinksCompassCompassCatalogQueryApiComponentQueryError:
+		if (QueryError{Message: component.Message, Extensions: component.Extensions}).IsNotFound() {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("%s", component.Message)
+	default:
+		return nil, false, fmt.Errorf("unexpected component result %T", component)
+	}
+}
+
 func resourceComponentLinkImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	// Import format: component_id/link_id or component_id:cloud_id/link_id
 	// For simplicity, we'll use component_id:link_id format
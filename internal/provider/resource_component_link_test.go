@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -41,7 +42,7 @@ resource "compass_component_link" "test" {
   component_id = "cmp-1"
   name         = "Repo"
   type         = "REPOSITORY"
-  url          = "https://example.com/repo"
+  url          = "https://github.com/example/repo"
 }
 `, server.URL)
 
@@ -57,7 +58,7 @@ resource "compass_component_link" "test" {
   component_id = "cmp-1"
   name         = "Repo-2"
   type         = "REPOSITORY"
-  url          = "https://example.com/repo2"
+  url          = "https://github.com/example/repo2"
   object_id    = "obj-123"
 }
 `, server.URL)
@@ -71,7 +72,7 @@ resource "compass_component_link" "test" {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", "Repo"),
 					resource.TestCheckResourceAttr(resourceName, "type", "REPOSITORY"),
-					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/repo"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://github.com/example/repo"),
 					resource.TestCheckResourceAttr(resourceName, "cloud_id", state.cloudID),
 				),
 			},
@@ -79,7 +80,7 @@ resource "compass_component_link" "test" {
 				Config: updated,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", "Repo-2"),
-					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/repo2"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://github.com/example/repo2"),
 					resource.TestCheckResourceAttr(resourceName, "object_id", "obj-123"),
 				),
 			},
@@ -99,3 +100,217 @@ resource "compass_component_link" "test" {
 		},
 	})
 }
+
+func TestResourceComponentLink_DeletedOutsideTerraform(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_link" "test" {
+  component_id = "cmp-1"
+  name         = "Repo"
+  type         = "REPOSITORY"
+  url          = "https://github.com/example/repo"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("compass_component_link.test", "name", "Repo"),
+			},
+			{
+				PreConfig: func() {
+					state.mu.Lock()
+					delete(state.links, "lnk-1")
+					state.mu.Unlock()
+				},
+				Config: config,
+				Check: func(*terraform.State) error {
+					state.mu.Lock()
+					defer state.mu.Unlock()
+					if state.links["lnk-1"] == nil {
+						return fmt.Errorf("expected the link to be recreated")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceComponentLink_DuplicateLinks(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	// Two links with the same fields can only be told apart by the ID returned on creation
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_link" "first" {
+  component_id = "cmp-1"
+  name         = "Repo"
+  type         = "REPOSITORY"
+  url          = "https://github.com/example/repo"
+}
+
+resource "compass_component_link" "second" {
+  component_id = "cmp-1"
+  name         = "Repo"
+  type         = "REPOSITORY"
+  url          = "https://github.com/example/repo"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					first := s.RootModule().Resources["compass_component_link.first"].Primary.ID
+					second := s.RootModule().Resources["compass_component_link.second"].Primary.ID
+					if first == second {
+						return fmt.Errorf("expected different link IDs, got %s twice", first)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceComponentLink_CreatedLinkNotReturned(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+	// The link is looked up by its fields and only listed by the second query
+	state.omitCreatedLink = true
+	state.hiddenLinkReads = 1
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_link" "test" {
+  component_id = "cmp-1"
+  name         = "Repo"
+  type         = "REPOSITORY"
+  url          = "https://github.com/example/repo"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("compass_component_link.test", "id", "lnk-1"),
+					resource.TestCheckResourceAttr("compass_component_link.test", "name", "Repo"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceComponentLink_MutationErrors(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+	state.failingMutations["createComponentLink"] = "Link URL is not a valid repository URL"
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_link" "test" {
+  component_id = "cmp-1"
+  name         = "Repo"
+  type         = "REPOSITORY"
+  url          = "https://github.com/example/repo"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`failed to create component link: GraphQL mutation returned success=false: Link URL is not a valid repository URL \(errorType: BAD_REQUEST, statusCode: 400\)`),
+			},
+		},
+	})
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComponentLinks() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComponentLinksCreate,
+		ReadContext:   resourceComponentLinksRead,
+		UpdateContext: resourceComponentLinksUpdate,
+		DeleteContext: resourceComponentLinksDelete,
+		Schema: map[string]*schema.Schema{
+			"component_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Compass component whose links are managed",
+			},
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Cloud ID of the Atlassian site. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
+			},
+			"link": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Links of the Compass component. Links of the component that are not listed here are deleted",
+				Elem:        componentLinkResource(),
+				Set:         componentLinkHash,
+			},
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateLinkBlocksRawConfig},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			// Bounds the wait for created links Compass did not return to show up on the component
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+		},
+	}
+}
+
+// componentLinkResource is the schema of a link block of a component.
+func componentLinkResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the link",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the link",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateLinkType,
+				Description:      "Type of the link. Valid values: DOCUMENT, CHAT_CHANNEL, REPOSITORY, PROJECT, DASHBOARD, ON_CALL, OTHER_LINK. CHAT_CHANNEL links must point to Slack or Microsoft Teams, REPOSITORY links to a git host",
+			},
+			"url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateLinkURL,
+				Description:      "URL of the link",
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The unique ID of the object the link points to (generally configured by integrations)",
+			},
+		},
+	}
+}
+
+// componentLinkHash identifies a link block by its fields. The computed id is left out,
+// so links from the configuration match the links read from Compass.
+func componentLinkHash(v interface{}) int {
+	link := v.(map[string]interface{})
+	name, _ := link["name"].(string)
+	linkType, _ := link["type"].(string)
+	url, _ := link["url"].(string)
+	objectID, _ := link["object_id"].(string)
+	return schema.HashString(fmt.Sprintf("%s|%s|%s|%s", name, linkType, url, objectID))
+}
+
+// flattenComponentLinks converts links read from Compass into link blocks.
+func flattenComponentLinks(links []ComponentLink) []interface{} {
+	result := make([]interface{}, 0, len(links))
+	for _, link := range links {
+		result = append(result, map[string]interface{}{
+			"id":        link.ID,
+			"name":      link.Name,
+			"type":      link.Type,
+			"url":       link.URL,
+			"object_id": link.ObjectID,
+		})
+	}
+	return result
+}
+
+// componentLinksFromFields converts links returned by the generated operations.
+func componentLinksFromFields(links []client.ComponentLinkFields) []ComponentLink {
+	result := make([]ComponentLink, 0, len(links))
+	for _, link := range links {
+		result = append(result, ComponentLink{
+			ID:       link.Id,
+			Name:     link.Name,
+			Type:     string(link.Type),
+			URL:      link.Url,
+			ObjectID: link.ObjectId,
+		})
+	}
+	return result
+}
+
+// expandComponentLinks converts link blocks into create inputs.
+func expandComponentLinks(links []interface{}) []client.CreateCompassLinkInput {
+	result := make([]client.CreateCompassLinkInput, 0, len(links))
+	for _, v := range links {
+		link := v.(map[string]interface{})
+		objectID, _ := link["object_id"].(string)
+		result = append(result, client.CreateCompassLinkInput{
+			Name:     link["name"].(string),
+			Type:     client.CompassLinkType(link["type"].(string)),
+			Url:      link["url"].(string),
+			ObjectId: objectID,
+		})
+	}
+	return result
+}
+
+// applyComponentLinks makes the links of a component match the given link blocks. Links
+// that already match are left alone, links with the name of a wanted link are updated in
+// place, the remaining links are deleted and the remaining wanted links are created.
+func applyComponentLinks(ctx context.Context, compassClient *client.Client, componentID string, links []interface{}, timeout time.Duration) error {
+	wanted := expandComponentLinks(links)
+
+	current, exists, err := getComponentLinks(ctx, compassClient, componentID)
+	if err != nil {
+		return fmt.Errorf("failed to read component links: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("failed to read component links: component %s not found", componentID)
+	}
+
+	// Links that match a wanted link exactly are unchanged
+	var changed []client.CreateCompassLinkInput
+	for _, link := range wanted {
+		if i := indexComponentLink(current, func(l client.ComponentLinkFields) bool {
+			return l.Name == link.Name && l.Type == link.Type && l.Url == link.Url && l.ObjectId == link.ObjectId
+		}); i >= 0 {
+			current = append(current[:i], current[i+1:]...)
+			continue
+		}
+		changed = append(changed, link)
+	}
+
+	// Links with the name of a changed link are updated, so they keep their ID
+	type linkUpdate struct {
+		id   string
+		link client.CreateCompassLinkInput
+	}
+	var updates []linkUpdate
+	var creates []client.CreateCompassLinkInput
+	for _, link := range changed {
+		if i := indexComponentLink(current, func(l client.ComponentLinkFields) bool {
+			return l.Name == link.Name
+		}); i >= 0 {
+			updates = append(updates, linkUpdate{id: current[i].Id, link: link})
+			current = append(current[:i], current[i+1:]...)
+			continue
+		}
+		creates = append(creates, link)
+	}
+
+	// Links left over are not wanted, they are deleted first to free their place
+	for _, link := range current {
+		if err := deleteComponentLink(ctx, compassClient, componentID, link.Id); err != nil {
+			return err
+		}
+	}
+
+	for _, update := range updates {
+		linkType := update.link.Type
+		input := client.UpdateCompassLinkInput{
+			Id:   update.id,
+			Name: &update.link.Name,
+			Type: &linkType,
+			Url:  &update.link.Url,
+		}
+		if update.link.ObjectId != "" {
+			input.ObjectId = &update.link.ObjectId
+		}
+		if err := updateComponentLink(ctx, compassClient, componentID, input); err != nil {
+			return err
+		}
+	}
+
+	for _, link := range creates {
+		if _, err := createComponentLink(ctx, compassClient, componentID, link, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexComponentLink returns the index of the first link matching match, or -1.
+func indexComponentLink(links []client.ComponentLinkFields, match func(client.ComponentLinkFields) bool) int {
+	for i, link := range links {
+		if match(link) {
+			return i
+		}
+	}
+	return -1
+}
+
+func resourceComponentLinksCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	componentID := d.Get("component_id").(string)
+
+	if _, diags := providerConfig.ResourceCloudID(ctx, d); diags.HasError() {
+		return diags
+	}
+
+	// Links the component already has are taken over, the ones not configured are deleted
+	if err := applyComponentLinks(ctx, compassClient, componentID, d.Get("link").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(componentID)
+
+	return resourceComponentLinksRead(ctx, d, m)
+}
+
+func resourceComponentLinksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	componentID := d.Id()
+
+	// cloud_id is not set yet when importing
+	if _, diags := providerConfig.ResourceCloudID(ctx, d); diags.HasError() {
+		return diags
+	}
+
+	links, exists, err := getComponentLinks(ctx, compassClient, componentID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read component links: %w", err))
+	}
+	if !exists {
+		// The links are gone together with their component
+		return removeNotFoundFromState(d, "component links")
+	}
+
+	// Every link of the component is set, so links added outside of Terraform show up as drift
+	d.Set("component_id", componentID)
+	if err := d.Set("link", flattenComponentLinks(componentLinksFromFields(links))); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set link: %w", err))
+	}
+
+	return nil
+}
+
+func resourceComponentLinksUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	if d.HasChange("link") {
+		if err := applyComponentLinks(ctx, compassClient, d.Id(), d.Get("link").(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceComponentLinksRead(ctx, d, m)
+}
+
+func resourceComponentLinksDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	componentID := d.Id()
+
+	current, exists, err := getComponentLinks(ctx, compassClient, componentID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read component links: %w", err))
+	}
+
+	// Only the links in state are deleted, links already gone are skipped
+	if exists {
+		for _, v := range d.Get("link").(*schema.Set).List() {
+			linkID, _ := v.(map[string]interface{})["id"].(string)
+			if indexComponentLink(current, func(l client.ComponentLinkFields) bool { return l.Id == linkID }) < 0 {
+				continue
+			}
+			if err := deleteComponentLink(ctx, compassClient, componentID, linkID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceComponentLinks_CRUD(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+	// Link added in the UI before the resource is created, it is deleted on create
+	state.links["lnk-ui"] = map[string]interface{}{
+		"id":          "lnk-ui",
+		"componentId": "cmp-1",
+		"name":        "Wiki",
+		"type":        "DOCUMENT",
+		"url":         "https://wiki.example.com",
+		"objectId":    "",
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component_links.test"
+	initial := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_links" "test" {
+  component_id = "cmp-1"
+
+  link {
+    name = "Repo"
+    type = "REPOSITORY"
+    url  = "https://github.com/example/repo"
+  }
+
+  link {
+    name = "Docs"
+    type = "DOCUMENT"
+    url  = "https://example.com/docs"
+  }
+}
+`, server.URL)
+
+	updated := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_links" "test" {
+  component_id = "cmp-1"
+
+  link {
+    name = "Repo"
+    type = "REPOSITORY"
+    url  = "https://github.com/example/repo-2"
+  }
+
+  link {
+    name = "Dashboard"
+    type = "DASHBOARD"
+    url  = "https://example.com/dashboard"
+  }
+}
+`, server.URL)
+
+	var repoID string
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: initial,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cmp-1"),
+					resource.TestCheckResourceAttr(resourceName, "cloud_id", state.cloudID),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"name": "Repo",
+						"type": "REPOSITORY",
+						"url":  "https://github.com/example/repo",
+					}),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if state.links["lnk-ui"] != nil {
+							return fmt.Errorf("expected the link missing from the configuration to be deleted")
+						}
+						for id, l := range state.links {
+							if l["name"] == "Repo" {
+								repoID = id
+							}
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "link.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"name": "Repo",
+						"url":  "https://github.com/example/repo-2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"name": "Dashboard",
+						"type": "DASHBOARD",
+					}),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if len(state.links) != 2 {
+							return fmt.Errorf("expected 2 links, got %d", len(state.links))
+						}
+						// The changed link is updated in place
+						if state.links[repoID]["url"] != "https://github.com/example/repo-2" {
+							return fmt.Errorf("expected link %s to be updated, got %v", repoID, state.links)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloud_id"},
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			state.mu.Lock()
+			defer state.mu.Unlock()
+			if len(state.links) != 0 {
+				return fmt.Errorf("expected all links to be deleted, got %d", len(state.links))
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceComponentLinks_DetectsExtraLinks(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_links" "test" {
+  component_id = "cmp-1"
+
+  link {
+    name = "Repo"
+    type = "REPOSITORY"
+    url  = "https://github.com/example/repo"
+  }
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("compass_component_links.test", "link.#", "1"),
+			},
+			{
+				// A link added outside of Terraform is drift
+				PreConfig: func() {
+					state.mu.Lock()
+					state.links["lnk-ui"] = map[string]interface{}{
+						"id":          "lnk-ui",
+						"componentId": "cmp-1",
+						"name":        "Chat",
+						"type":        "CHAT_CHANNEL",
+						"url":         "https://slack.com/channels/svc-a",
+						"objectId":    "",
+					}
+					state.mu.Unlock()
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: func(*terraform.State) error {
+					state.mu.Lock()
+					defer state.mu.Unlock()
+					if state.links["lnk-ui"] != nil {
+						return fmt.Errorf("expected the link added outside of Terraform to be deleted")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComponentRelationship() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComponentRelationshipCreate,
+		ReadContext:   resourceComponentRelationshipRead,
+		DeleteContext: resourceComponentRelationshipDelete,
+		Schema: map[string]*schema.Schema{
+			"start_component_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the component the relationship starts from (e.g. the component that depends on another one)",
+			},
+			"end_component_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the component the relationship points to (e.g. the dependency)",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "DEPENDS_ON",
+				ValidateDiagFunc: validateRelationshipType,
+				Description:      "Type of the relationship. Valid values: DEPENDS_ON. Defaults to DEPENDS_ON",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComponentRelationshipImport,
+		},
+	}
+}
+
+func resourceComponentRelationshipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	startComponentID := d.Get("start_component_id").(string)
+	endComponentID := d.Get("end_component_id").(string)
+	relationshipType := d.Get("type").(string)
+
+	response, err := client.CreateRelationship(ctx, compassClient, client.CreateCompassRelationshipInput{
+		StartNodeId: startComponentID,
+		EndNodeId:   endComponentID,
+		Type:        client.CompassRelationshipType(relationshipType),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create component relationship: %w", err))
+	}
+
+	if !response.Compass.CreateRelationship.Success {
+		return diag.FromErr(mutationError("create component relationship", response.Compass.CreateRelationship.Errors))
+	}
+
+	// Relationships have no ID of their own, they are identified by both ends and the type
+	d.SetId(buildRelationshipID(startComponentID, endComponentID, relationshipType))
+
+	return resourceComponentRelationshipRead(ctx, d, m)
+}
+
+func resourceComponentRelationshipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	startComponentID, endComponentID, relationshipType, err := parseRelationshipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	found, err := hasComponentRelationship(ctx, compassClient, startComponentID, endComponentID, relationshipType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !found {
+		return removeNotFoundFromState(d, "component relationship")
+	}
+
+	d.Set("start_component_id", startComponentID)
+	d.Set("end_component_id", endComponentID)
+	d.Set("type", relationshipType)
+
+	return nil
+}
+
+func resourceComponentRelationshipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	response, err := client.DeleteRelationship(ctx, compassClient, client.DeleteCompassRelationshipInput{
+		StartNodeId: d.Get("start_component_id").(string),
+		EndNodeId:   d.Get("end_component_id").(string),
+		Type:        client.CompassRelationshipType(d.Get("type").(string)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete component relationship: %w", err))
+	}
+
+	if !response.Compass.DeleteRelationship.Success {
+		return diag.FromErr(mutationError("delete component relationship", response.Compass.DeleteRelationship.Errors))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// hasComponentRelationship reports whether the relationship is among the outgoing relationships
+// of the start component. The relationships are paged through until it is found, so components
+// with many relationships do not lose the ones past the first page. A start component that does
+// not exist anymore is reported as a missing relationship.
+func hasComponentRelationship(ctx context.Context, compassClient *client.Client, startComponentID, endComponentID, relationshipType string) (bool, error) {
+	var found bool
+
+	err := client.Paginate(ctx, func(ctx context.Context, cursor string) (client.PageInfo, error) {
+		response, err := client.GetComponentRelationships(ctx, compassClient, startComponentID, client.CompassRelationshipQuery{
+			First: 50,
+			After: cursor,
+		})
+		if err != nil {
+			if client.IsNotFound(err) {
+				return client.PageInfo{}, nil
+			}
+			return client.PageInfo{}, fmt.Errorf("failed to read component relationship: %w", err)
+		}
+
+		var relationships client.ComponentRelationships
+		switch component := response.Compass.Component.(type) {
+		case *client.GetComponentRelationshipsCompassCompassCatalogQueryApiComponentCompassComponent:
+			relationships = component.Relationships
+		case *client.GetComponentRelationshipsCompassCompassCatalogQueryApiComponentQueryError:
+			if (QueryError{Message: component.Message, Extensions: component.Extensions}).IsNotFound() {
+				return client.PageInfo{}, nil
+			}
+			return client.PageInfo{}, fmt.Errorf("failed to read component relationship: %s", component.Message)
+		default:
+			return client.PageInfo{}, fmt.Errorf("unexpected component result %T", component)
+		}
+
+		connection, ok := relationships.(*client.ComponentRelationshipsCompassRelationshipConnection)
+		if !ok {
+			if queryError, ok := relationships.(*client.ComponentRelationshipsQueryError); ok {
+				return client.PageInfo{}, fmt.Errorf("failed to read component relationship: %s", queryError.Message)
+			}
+			return client.PageInfo{}, fmt.Errorf("unexpected relationships result %T", relationships)
+		}
+
+		for _, relationship := range connection.Nodes {
+			if string(relationship.Type) == relationshipType &&
+				relationshipNodeID(relationship.StartNode) == startComponentID &&
+				relationshipNodeID(relationship.EndNode) == endComponentID {
+				found = true
+				// No need to fetch the remaining pages
+				return client.PageInfo{}, nil
+			}
+		}
+		return connection.PageInfo, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// relationshipNodeID returns the ID of the component at one end of a relationship.
+func relationshipNodeID(node client.ComponentRelationshipNode) string {
+	if component, ok := node.(*client.ComponentRelationshipNodeCompassComponent); ok {
+		return component.Id
+	}
+	return ""
+}
+
+func resourceComponentRelationshipImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// Import format: start_component_id:end_component_id:type
+	startComponentID, endComponentID, relationshipType, err := parseRelationshipID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(buildRelationshipID(startComponentID, endComponentID, relationshipType))
+	d.Set("start_component_id", startComponentID)
+	d.Set("end_component_id", endComponentID)
+	d.Set("type", relationshipType)
+
+	diags := resourceComponentRelationshipRead(ctx, d, m)
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to read imported resource: %v", diags)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("relationship %s not found", buildRelationshipID(startComponentID, endComponentID, relationshipType))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildRelationshipID(startComponentID, endComponentID, relationshipType string) string {
+	return fmt.Sprintf("%s:%s:%s", startComponentID, endComponentID, relationshipType)
+}
+
+// parseRelationshipID splits an ID in the start_component_id:end_component_id:type format.
+// Component IDs are ARIs which contain colons themselves, so the type is taken from the last
+// colon and the two component IDs are split at the beginning of the second ARI.
+func parseRelationshipID(id string) (string, string, string, error) {
+	idx := strings.LastIndex(id, ":")
+	if idx <= 0 || idx == len(id)-1 {
+		return "", "", "", fmt.Errorf("invalid relationship ID. Expected start_component_id:end_component_id:type, got: %s", id)
+	}
+	components, relationshipType := id[:idx], id[idx+1:]
+
+	var startComponentID, endComponentID string
+	if sep := strings.Index(components, ":ari:"); sep > 0 {
+		startComponentID, endComponentID = components[:sep], components[sep+1:]
+	} else if parts := strings.Split(components, ":"); len(parts) == 2 {
+		startComponentID, endComponentID = parts[0], parts[1]
+	}
+
+	if startComponentID == "" || endComponentID == "" {
+		return "", "", "", fmt.Errorf("invalid relationship ID. Expected start_component_id:end_component_id:type, got: %s", id)
+	}
+
+	return startComponentID, endComponentID, relationshipType, nil
+}
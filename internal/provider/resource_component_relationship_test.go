@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceComponentRelationship_CRUD(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	// Seed the two components the relationship connects (simulate that they exist in API)
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+	state.components["cmp-2"] = map[string]interface{}{
+		"id":     "cmp-2",
+		"name":   "svc-b",
+		"typeId": "type-service",
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component_relationship.test"
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_relationship" "test" {
+  start_component_id = "cmp-1"
+  end_component_id   = "cmp-2"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cmp-1:cmp-2:DEPENDS_ON"),
+					resource.TestCheckResourceAttr(resourceName, "start_component_id", "cmp-1"),
+					resource.TestCheckResourceAttr(resourceName, "end_component_id", "cmp-2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "DEPENDS_ON"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceComponentRelationship_Paginated(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{"id": "cmp-1", "name": "svc-a", "typeId": "type-service"}
+	state.components["cmp-2"] = map[string]interface{}{"id": "cmp-2", "name": "svc-b", "typeId": "type-service"}
+	// Relationships listed before cmp-1 -> cmp-2, so it ends up past the first page
+	for i := 10; i < 70; i++ {
+		endNodeID := fmt.Sprintf("cmp-%d", i)
+		state.relationships["cmp-1|"+endNodeID+"|DEPENDS_ON"] = map[string]interface{}{
+			"startNodeId": "cmp-1",
+			"endNodeId":   endNodeID,
+			"type":        "DEPENDS_ON",
+		}
+	}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component_relationship.test"
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_relationship" "test" {
+  start_component_id = "cmp-1"
+  end_component_id   = "cmp-2"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cmp-1:cmp-2:DEPENDS_ON"),
+				),
+			},
+			{
+				// The relationship is still found on refresh, so no changes are planned
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceComponentRelationship_DeletedOutsideTerraform(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{"id": "cmp-1", "name": "svc-a", "typeId": "type-service"}
+	state.components["cmp-2"] = map[string]interface{}{"id": "cmp-2", "name": "svc-b", "typeId": "type-service"}
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_relationship" "test" {
+  start_component_id = "cmp-1"
+  end_component_id   = "cmp-2"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// The relationship is gone from the paged list
+				PreConfig: func() {
+					state.mu.Lock()
+					delete(state.relationships, "cmp-1|cmp-2|DEPENDS_ON")
+					state.mu.Unlock()
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The start component is gone, Compass answers with a not-found QueryError
+				PreConfig: func() {
+					state.mu.Lock()
+					delete(state.components, "cmp-1")
+					state.mu.Unlock()
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestParseRelationshipID(t *testing.T) {
+	start := "ari:cloud:compass:cloud-123:component/ws/aaa"
+	end := "ari:cloud:compass:cloud-123:component/ws/bbb"
+
+	cases := []struct {
+		id        string
+		wantStart string
+		wantEnd   string
+		wantType  string
+		wantErr   bool
+	}{
+		{id: "cmp-1:cmp-2:DEPENDS_ON", wantStart: "cmp-1", wantEnd: "cmp-2", wantType: "DEPENDS_ON"},
+		{id: start + ":" + end + ":DEPENDS_ON", wantStart: start, wantEnd: end, wantType: "DEPENDS_ON"},
+		{id: "cmp-1:DEPENDS_ON", wantErr: true},
+		{id: "cmp-1:cmp-2:", wantErr: true},
+		{id: "", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		gotStart, gotEnd, gotType, err := parseRelationshipID(tc.id)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("parseRelationshipID(%q): expected error, got none", tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseRelationshipID(%q): unexpected error: %v", tc.id, err)
+			continue
+		}
+		if gotStart != tc.wantStart || gotEnd != tc.wantEnd || gotType != tc.wantType {
+			t.Errorf("parseRelationshipID(%q) = (%q, %q, %q), want (%q, %q, %q)", tc.id, gotStart, gotEnd, gotType, tc.wantStart, tc.wantEnd, tc.wantType)
+		}
+	}
+}
+
+func TestResourceComponentRelationship_MutationErrors(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.components["cmp-1"] = map[string]interface{}{
+		"id":     "cmp-1",
+		"name":   "svc-a",
+		"typeId": "type-service",
+	}
+	state.components["cmp-2"] = map[string]interface{}{
+		"id":     "cmp-2",
+		"name":   "svc-b",
+		"typeId": "type-service",
+	}
+	state.failingMutations["createRelationship"] = "Relationship already exists"
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_relationship" "test" {
+  start_component_id = "cmp-1"
+  end_component_id   = "cmp-2"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`failed to create component relationship: GraphQL mutation returned success=false: Relationship already exists \(errorType: BAD_REQUEST, statusCode: 400\)`),
+			},
+		},
+	})
+}
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestResourceComponent_CRUD(t *testing.T) {
@@ -80,3 +83,585 @@ resource "compass_component" "test" {
 		},
 	})
 }
+
+func TestResourceComponent_Labels(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component.test"
+	config := func(labels string) string {
+		return fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name   = "svc-a"
+  type   = "SERVICE"
+  labels = %s
+}
+`, server.URL, labels)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["team-a", "tier-1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "team-a"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "tier-1"),
+				),
+			},
+			{
+				Config: config(`["team-a", "tier-2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "team-a"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "tier-2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloud_id"},
+			},
+		},
+	})
+}
+
+func TestResourceComponent_CustomFields(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component.test"
+	initial := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+
+  custom_field {
+    definition_id = "cf-tier"
+    type          = "NUMBER"
+    number_value  = 1
+  }
+
+  custom_field {
+    definition_id = "cf-pii"
+    type          = "BOOLEAN"
+    boolean_value = true
+  }
+}
+`, server.URL)
+
+	updated := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+
+  custom_field {
+    definition_id = "cf-tier"
+    type          = "NUMBER"
+    number_value  = 2
+  }
+
+  custom_field {
+    definition_id           = "cf-classification"
+    type                    = "MULTI_SELECT"
+    multi_select_option_ids = ["opt-internal", "opt-confidential"]
+  }
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: initial,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_field.*", map[string]string{
+						"definition_id": "cf-tier",
+						"type":          "NUMBER",
+						"number_value":  "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_field.*", map[string]string{
+						"definition_id": "cf-pii",
+						"type":          "BOOLEAN",
+						"boolean_value": "true",
+					}),
+				),
+			},
+			{
+				// cf-pii is removed from the configuration and cleared on the component
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_field.*", map[string]string{
+						"definition_id": "cf-tier",
+						"number_value":  "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_field.*", map[string]string{
+						"definition_id":             "cf-classification",
+						"type":                      "MULTI_SELECT",
+						"multi_select_option_ids.#": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloud_id"},
+			},
+		},
+	})
+}
+
+func TestResourceComponent_TypeChange(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component.test"
+	config := func(typeAttr string) string {
+		return fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  %s
+}
+`, server.URL, typeAttr)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`type = "SERVICE"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cmp-1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SERVICE"),
+					resource.TestCheckResourceAttr(resourceName, "type_id", "type-service"),
+				),
+			},
+			{
+				// Changing type updates the component in place and refreshes type_id
+				Config: config(`type = "LIBRARY"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cmp-1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "LIBRARY"),
+					resource.TestCheckResourceAttr(resourceName, "type_id", "type-library"),
+				),
+			},
+			{
+				// type is mapped back from the typeId of the component on import
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloud_id"},
+			},
+			{
+				// Switching to a custom type_id clears type
+				Config: config(`type_id = "type-custom"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cmp-1"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+					resource.TestCheckResourceAttr(resourceName, "type_id", "type-custom"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceComponentCustomizeDiff_Type(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "cmp-1",
+		Attributes: map[string]string{
+			"id":       "cmp-1",
+			"cloud_id": "cloud-123",
+			"name":     "svc-a",
+			"type":     "SERVICE",
+			"type_id":  "type-service",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		computed string
+	}{
+		{
+			name:     "type",
+			config:   map[string]interface{}{"name": "svc-a", "type": "LIBRARY"},
+			computed: "type_id",
+		},
+		{
+			name:     "type_id",
+			config:   map[string]interface{}{"name": "svc-a", "type_id": "type-custom"},
+			computed: "type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := resourceComponent().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff.RequiresNew() {
+				t.Errorf("expected the type change to be planned in place")
+			}
+			attr := diff.Attributes[tt.computed]
+			if attr == nil || !attr.NewComputed {
+				t.Errorf("expected %s to be unknown until apply, got %#v", tt.computed, attr)
+			}
+		})
+	}
+}
+
+func TestResourceComponent_Links(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component.test"
+	initial := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+
+  link {
+    name = "Repo"
+    type = "REPOSITORY"
+    url  = "https://github.com/example/repo"
+  }
+
+  link {
+    name = "Runbook"
+    type = "DOCUMENT"
+    url  = "https://example.com/runbook"
+  }
+
+  link {
+    name = "Chat"
+    type = "CHAT_CHANNEL"
+    url  = "https://slack.com/channels/svc-a"
+  }
+}
+`, server.URL)
+
+	updated := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+
+  link {
+    name = "Repo"
+    type = "REPOSITORY"
+    url  = "https://github.com/example/repo-2"
+  }
+
+  link {
+    name = "Runbook"
+    type = "DOCUMENT"
+    url  = "https://example.com/runbook"
+  }
+}
+`, server.URL)
+
+	cleared := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+  link = []
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: initial,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "link.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"name": "Chat",
+						"type": "CHAT_CHANNEL",
+						"url":  "https://slack.com/channels/svc-a",
+					}),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "link.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"name": "Repo",
+						"url":  "https://github.com/example/repo-2",
+					}),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if len(state.links) != 2 {
+							return fmt.Errorf("expected 2 links, got %d", len(state.links))
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloud_id"},
+			},
+			{
+				// link = [] deletes all links, omitting link would leave them alone
+				Config: cleared,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "link.#", "0"),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if len(state.links) != 0 {
+							return fmt.Errorf("expected all links to be deleted, got %d", len(state.links))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceComponent_DeletedOutsideTerraform(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("compass_component.test", "name", "svc-a"),
+			},
+			{
+				// The mock answers reads of the deleted component with a not-found QueryError
+				PreConfig: func() {
+					state.mu.Lock()
+					delete(state.components, "cmp-1")
+					state.mu.Unlock()
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("compass_component.test", "id", "cmp-1"),
+					func(*terraform.State) error {
+						state.mu.Lock()
+						defer state.mu.Unlock()
+						if state.components["cmp-1"] == nil {
+							return fmt.Errorf("expected the component to be recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceComponent_MutationErrors(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	configTemplate := `
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component" "test" {
+  name        = "svc-a"
+  type        = "SERVICE"
+  description = "%s"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configTemplate, server.URL, "first"),
+				Check:  resource.TestCheckResourceAttr("compass_component.test", "description", "first"),
+			},
+			{
+				PreConfig: func() {
+					state.mu.Lock()
+					state.failingMutations["updateComponent"] = "Description is too long"
+					state.mu.Unlock()
+				},
+				Config:      fmt.Sprintf(configTemplate, server.URL, "second"),
+				ExpectError: regexp.MustCompile(`failed to update component: GraphQL mutation returned success=false: Description is too long \(errorType: BAD_REQUEST, statusCode: 400\)`),
+			},
+			{
+				// Deleting the component is not affected by the failing update
+				PreConfig: func() {
+					state.mu.Lock()
+					delete(state.failingMutations, "updateComponent")
+					state.mu.Unlock()
+				},
+				Config: fmt.Sprintf(configTemplate, server.URL, "first"),
+			},
+		},
+	})
+}
+
+func TestResourceComponent_InvalidCustomFields(t *testing.T) {
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := func(customField string) string {
+		return fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  cloud_id  = "cloud-123"
+}
+
+resource "compass_component" "test" {
+  name = "svc-a"
+  type = "SERVICE"
+
+  custom_field {
+    definition_id = "cf-tier"
+%s
+  }
+}
+`, customField)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`    type = "TEXT"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("text_value is required for TEXT custom fields"),
+			},
+			{
+				Config: config(`
+    type          = "NUMBER"
+    number_value  = 1
+    boolean_value = false
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("boolean_value can't be set for NUMBER custom fields, only number_value"),
+			},
+		},
+	})
+}
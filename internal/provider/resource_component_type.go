@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComponentType() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComponentTypeCreate,
+		ReadContext:   resourceComponentTypeRead,
+		UpdateContext: resourceComponentTypeUpdate,
+		DeleteContext: resourceComponentTypeDelete,
+		Schema: map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Cloud ID of the Atlassian site. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the component type",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the component type",
+			},
+			"icon_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of the icon displayed for components of this type",
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the icon displayed for components of this type",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceComponentTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := client.CreateCompassComponentTypeInput{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IconKey:     d.Get("icon_key").(string),
+	}
+
+	response, err := client.CreateComponentType(ctx, compassClient, cloudID, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create component type: %w", err))
+	}
+
+	if !response.Compass.CreateComponentType.Success {
+		return diag.FromErr(mutationError("create component type", response.Compass.CreateComponentType.Errors))
+	}
+
+	d.SetId(response.Compass.CreateComponentType.CreatedComponentType.Id)
+
+	return resourceComponentTypeRead(ctx, d, m)
+}
+
+func resourceComponentTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	// cloud_id is not set yet when importing
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	componentType, found, err := getComponentType(ctx, compassClient, cloudID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !found {
+		return removeNotFoundFromState(d, "component type")
+	}
+
+	// icon_key is not returned in read, so it is kept from state
+	d.Set("cloud_id", cloudID)
+	d.Set("name", componentType.Name)
+	d.Set("description", componentType.Description)
+	d.Set("icon_url", componentType.IconUrl)
+
+	return nil
+}
+
+func resourceComponentTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	// Check if any updatable fields have changed
+	if !d.HasChanges("name", "description", "icon_key") {
+		// No changes to updatable fields, just read the state
+		return resourceComponentTypeRead(ctx, d, m)
+	}
+
+	// Build update input
+	input := client.UpdateCompassComponentTypeMetadataInput{
+		Id: d.Id(),
+	}
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		input.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		// Include description even if empty to allow clearing it
+		input.Description = &description
+	}
+
+	if d.HasChange("icon_key") {
+		iconKey := d.Get("icon_key").(string)
+		input.IconKey = &iconKey
+	}
+
+	response, err := client.UpdateComponentTypeMetadata(ctx, compassClient, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update component type: %w", err))
+	}
+
+	if !response.Compass.UpdateComponentTypeMetadata.Success {
+		return diag.FromErr(mutationError("update component type", response.Compass.UpdateComponentTypeMetadata.Errors))
+	}
+
+	// Update successful, read the latest state
+	return resourceComponentTypeRead(ctx, d, m)
+}
+
+func resourceComponentTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	response, err := client.DeleteComponentType(ctx, compassClient, client.DeleteCompassComponentTypeInput{
+		Id: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete component type: %w", err))
+	}
+
+	if !response.Compass.DeleteComponentType.Success {
+		return diag.FromErr(mutationError("delete component type", response.Compass.DeleteComponentType.Errors))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getComponentType returns a component type of the site, and false when it does not exist.
+func getComponentType(ctx context.Context, compassClient *client.Client, cloudID, id string) (client.ComponentTypeFields, bool, error) {
+	response, err := client.GetComponentType(ctx, compassClient, cloudID, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return client.ComponentTypeFields{}, false, nil
+		}
+		return client.ComponentTypeFields{}, false, fmt.Errorf("failed to read component type: %w", err)
+	}
+
+	switch componentType := response.Compass.ComponentType.(type) {
+	case *client.GetComponentTypeCompassCompassCatalogQueryApiComponentTypeCompassComponentTypeObject:
+		return componentType.ComponentTypeFields, true, nil
+	case *client.GetComponentTypeCompassCompassCatalogQueryApiComponentTypeQueryError:
+		if (QueryError{Message: componentType.Message, Extensions: componentType.Extensions}).IsNotFound() {
+			return client.ComponentTypeFields{}, false, nil
+		}
+		return client.ComponentTypeFields{}, false, fmt.Errorf("failed to read component type: %s", componentType.Message)
+	default:
+		return client.ComponentTypeFields{}, false, fmt.Errorf("unexpected component type result %T", componentType)
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceComponentType_CRUD(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_component_type.test"
+	initial := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_type" "test" {
+  name        = "ML model"
+  description = "Machine learning models"
+  icon_key    = "ml"
+}
+
+resource "compass_component" "test" {
+  name    = "churn-model"
+  type_id = compass_component_type.test.id
+}
+`, server.URL)
+
+	updated := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_type" "test" {
+  name     = "Machine learning model"
+  icon_key = "robot"
+}
+
+resource "compass_component" "test" {
+  name    = "churn-model"
+  type_id = compass_component_type.test.id
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: initial,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "ML model"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Machine learning models"),
+					resource.TestCheckResourceAttr(resourceName, "icon_url", "https://example.com/icons/ml.svg"),
+					resource.TestCheckResourceAttr(resourceName, "cloud_id", state.cloudID),
+					resource.TestCheckResourceAttrPair("compass_component.test", "type_id", resourceName, "id"),
+					resource.TestCheckResourceAttr("compass_component.test", "type", ""),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Machine learning model"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "icon_url", "https://example.com/icons/robot.svg"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"icon_key"},
+			},
+		},
+	})
+}
+
+func TestResourceComponentType_DeletedOutsideTerraform(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_type" "test" {
+  name = "ML model"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("compass_component_type.test", "id", "type-custom-1"),
+			},
+			{
+				PreConfig: func() {
+					state.mu.Lock()
+					delete(state.types, "type-custom-1")
+					state.mu.Unlock()
+				},
+				Config: config,
+				Check: func(*terraform.State) error {
+					state.mu.Lock()
+					defer state.mu.Unlock()
+					if len(state.types) != 1 {
+						return fmt.Errorf("expected the component type to be recreated, got %d types", len(state.types))
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceComponentType_MutationErrors(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.failingMutations["createComponentType"] = "Component type name is already taken"
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_component_type" "test" {
+  name = "ML model"
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`failed to create component type: GraphQL mutation returned success=false: Component type name is already taken \(errorType: BAD_REQUEST, statusCode: 400\)`),
+			},
+		},
+	})
+}
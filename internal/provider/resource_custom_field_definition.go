@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customFieldDefinitionTypes maps the type values to the member of the create/update
+// input used for them and the CompassCustomFieldDefinition union member returned on read.
+var customFieldDefinitionTypes = map[string]struct {
+	inputField string
+	typeName   string
+}{
+	"BOOLEAN":       {inputField: "booleanFieldDefinition", typeName: "CompassCustomBooleanFieldDefinition"},
+	"TEXT":          {inputField: "textFieldDefinition", typeName: "CompassCustomTextFieldDefinition"},
+	"NUMBER":        {inputField: "numberFieldDefinition", typeName: "CompassCustomNumberFieldDefinition"},
+	"SINGLE_SELECT": {inputField: "singleSelectFieldDefinition", typeName: "CompassCustomSingleSelectFieldDefinition"},
+	"MULTI_SELECT":  {inputField: "multiSelectFieldDefinition", typeName: "CompassCustomMultiSelectFieldDefinition"},
+	"USER":          {inputField: "userFieldDefinition", typeName: "CompassCustomUserFieldDefinition"},
+}
+
+func resourceCustomFieldDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext:                  resourceCustomFieldDefinitionCreate,
+		ReadContext:                    resourceCustomFieldDefinitionRead,
+		UpdateContext:                  resourceCustomFieldDefinitionUpdate,
+		DeleteContext:                  resourceCustomFieldDefinitionDelete,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateCustomFieldDefinitionRawConfig},
+		Schema: map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Cloud ID of the Atlassian site. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the custom field definition",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the custom field definition",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateCustomFieldType,
+				Description:      "Type of the custom field. Valid values: BOOLEAN, TEXT, NUMBER, SINGLE_SELECT, MULTI_SELECT, USER",
+			},
+			"options": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Allowed option values. Required for SINGLE_SELECT and MULTI_SELECT fields, not allowed for other types",
+			},
+			"component_types": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateComponentType},
+				Description: "Component types the custom field applies to. Valid values: SERVICE, LIBRARY, APPLICATION, INFRASTRUCTURE, DATABASE, DOCUMENTATION",
+			},
+			"option_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the options keyed by option value, to be used in custom_field blocks of compass_component",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceCustomFieldDefinitionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	fieldType := d.Get("type").(string)
+	mapping, ok := customFieldDefinitionTypes[fieldType]
+	if !ok {
+		return diag.FromErr(invalidCustomFieldTypeError(fieldType))
+	}
+
+	options := expandStringSet(d.Get("options").(*schema.Set))
+	componentTypes := expandStringSet(d.Get("component_types").(*schema.Set))
+
+	// Build input according to CompassCreateCustomFieldDefinitionInput structure:
+	// exactly one of booleanFieldDefinition, textFieldDefinition, ... must be set
+	definitionInput := map[string]interface{}{
+		"cloudId":        cloudID,
+		"name":           d.Get("name").(string),
+		"componentTypes": componentTypes,
+	}
+
+	if description := d.Get("description").(string); description != "" {
+		definitionInput["description"] = description
+	}
+
+	if isSelectCustomFieldType(fieldType) {
+		optionInputs := make([]map[string]interface{}, 0, len(options))
+		for _, option := range options {
+			optionInputs = append(optionInputs, map[string]interface{}{"value": option})
+		}
+		definitionInput["options"] = optionInputs
+	}
+
+	response, err := client.CreateCustomFieldDefinition(ctx, compassClient, map[string]interface{}{
+		mapping.inputField: definitionInput,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create custom field definition: %w", err))
+	}
+
+	if !response.Compass.CreateCustomFieldDefinition.Success {
+		return diag.FromErr(mutationError("create custom field definition", response.Compass.CreateCustomFieldDefinition.Errors))
+	}
+
+	d.SetId(response.Compass.CreateCustomFieldDefinition.CustomFieldDefinition.GetId())
+
+	return resourceCustomFieldDefinitionRead(ctx, d, m)
+}
+
+func resourceCustomFieldDefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	response, err := client.GetCustomFieldDefinition(ctx, compassClient, cloudID, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return removeNotFoundFromState(d, "custom field definition")
+		}
+		return diag.FromErr(fmt.Errorf("failed to read custom field definition: %w", err))
+	}
+
+	definition := response.Compass.CustomFieldDefinition
+	if definition.TypeName == "QueryError" {
+		if (QueryError{Message: definition.Message, Extensions: definition.Extensions}).IsNotFound() {
+			return removeNotFoundFromState(d, "custom field definition")
+		}
+		return diag.Errorf("failed to read custom field definition: %s", definition.Message)
+	}
+
+	// Map the union member back to the type value
+	fieldType := ""
+	for t, mapping := range customFieldDefinitionTypes {
+		if mapping.typeName == definition.TypeName {
+			fieldType = t
+			break
+		}
+	}
+	if fieldType == "" {
+		return diag.Errorf("unsupported custom field definition type: %s", definition.TypeName)
+	}
+
+	options := make([]string, 0, len(definition.Options))
+	optionIDs := make(map[string]string, len(definition.Options))
+	for _, option := range definition.Options {
+		options = append(options, option.Value)
+		optionIDs[option.Value] = option.ID
+	}
+
+	d.Set("cloud_id", cloudID)
+	d.Set("name", definition.Name)
+	d.Set("description", definition.Description)
+	d.Set("type", fieldType)
+	d.Set("options", options)
+	d.Set("option_ids", optionIDs)
+	d.Set("component_types", definition.ComponentTypes)
+
+	return nil
+}
+
+func resourceCustomFieldDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	// Check if any updatable fields have changed
+	if !d.HasChanges("name", "description", "options", "component_types") {
+		// No changes to updatable fields, just read the state
+		return resourceCustomFieldDefinitionRead(ctx, d, m)
+	}
+
+	fieldType := d.Get("type").(string)
+	mapping, ok := customFieldDefinitionTypes[fieldType]
+	if !ok {
+		return diag.FromErr(invalidCustomFieldTypeError(fieldType))
+	}
+
+	// Build update input, the member matching the type carries the changes
+	definitionInput := map[string]interface{}{
+		"id": d.Id(),
+	}
+
+	if d.HasChange("name") {
+		definitionInput["name"] = d.Get("name").(string)
+	}
+
+	if d.HasChange("description") {
+		// Include description even if empty to allow clearing it
+		definitionInput["description"] = d.Get("description").(string)
+	}
+
+	if d.HasChange("component_types") {
+		definitionInput["componentTypes"] = expandStringSet(d.Get("component_types").(*schema.Set))
+	}
+
+	if d.HasChange("options") {
+		// Options are created and deleted individually so existing values on components are kept
+		oldOptions, newOptions := d.GetChange("options")
+		optionIDs := d.Get("option_ids").(map[string]interface{})
+
+		var createOptions []map[string]interface{}
+		for _, value := range expandStringSet(newOptions.(*schema.Set).Difference(oldOptions.(*schema.Set))) {
+			createOptions = append(createOptions, map[string]interface{}{"value": value})
+		}
+		var deleteOptions []string
+		for _, value := range expandStringSet(oldOptions.(*schema.Set).Difference(newOptions.(*schema.Set))) {
+			if id, ok := optionIDs[value].(string); ok {
+				deleteOptions = append(deleteOptions, id)
+			}
+		}
+
+		if len(createOptions) > 0 {
+			definitionInput["createOptions"] = createOptions
+		}
+		if len(deleteOptions) > 0 {
+			definitionInput["deleteOptions"] = deleteOptions
+		}
+	}
+
+	response, err := client.UpdateCustomFieldDefinition(ctx, compassClient, map[string]interface{}{
+		mapping.inputField: definitionInput,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update custom field definition: %w", err))
+	}
+
+	if !response.Compass.UpdateCustomFieldDefinition.Success {
+		return diag.FromErr(mutationError("update custom field definition", response.Compass.UpdateCustomFieldDefinition.Errors))
+	}
+
+	// Update successful, read the latest state
+	return resourceCustomFieldDefinitionRead(ctx, d, m)
+}
+
+func resourceCustomFieldDefinitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	response, err := client.DeleteCustomFieldDefinition(ctx, compassClient, client.CompassDeleteCustomFieldDefinitionInput{
+		Id: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete custom field definition: %w", err))
+	}
+
+	if !response.Compass.DeleteCustomFieldDefinition.Success {
+		return diag.FromErr(mutationError("delete custom field definition", response.Compass.DeleteCustomFieldDefinition.Errors))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isSelectCustomFieldType(fieldType string) bool {
+	return fieldType == "SINGLE_SELECT" || fieldType == "MULTI_SELECT"
+}
+
+// validateCustomFieldDefinitionRawConfig checks that options are set for select fields and only
+// for them, so a wrong combination is reported by terraform validate instead of during apply.
+// Values that are not known yet are skipped.
+func validateCustomFieldDefinitionRawConfig(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+		return
+	}
+	fieldType, options := req.RawConfig.GetAttr("type"), req.RawConfig.GetAttr("options")
+	if fieldType.IsNull() || !fieldType.IsKnown() || !options.IsKnown() {
+		return
+	}
+
+	optionCount := 0
+	if !options.IsNull() {
+		optionCount = options.LengthInt()
+	}
+
+	if err := validateCustomFieldDefinitionOptions(fieldType.AsString(), optionCount); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid custom field options",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("options"),
+		})
+	}
+}
+
+func validateCustomFieldDefinitionOptions(fieldType string, optionCount int) error {
+	if isSelectCustomFieldType(fieldType) && optionCount == 0 {
+		return fmt.Errorf("options are required for %s custom fields", fieldType)
+	}
+	if !isSelectCustomFieldType(fieldType) && optionCount > 0 {
+		return fmt.Errorf("options can only be set for SINGLE_SELECT and MULTI_SELECT custom fields, got type %s", fieldType)
+	}
+	return nil
+}
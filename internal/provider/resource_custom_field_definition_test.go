@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceCustomFieldDefinition_CRUD(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_custom_field_definition.test"
+	initial := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_custom_field_definition" "test" {
+  name            = "Tier"
+  description     = "Service tier"
+  type            = "SINGLE_SELECT"
+  options         = ["tier-1", "tier-2"]
+  component_types = ["SERVICE"]
+}
+`, server.URL)
+
+	updated := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_custom_field_definition" "test" {
+  name            = "Service tier"
+  type            = "SINGLE_SELECT"
+  options         = ["tier-1", "tier-3"]
+  component_types = ["SERVICE", "APPLICATION"]
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: initial,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Tier"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Service tier"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SINGLE_SELECT"),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "option_ids.tier-1", "opt-1"),
+					resource.TestCheckResourceAttr(resourceName, "component_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cloud_id", state.cloudID),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Service tier"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "options.*", "tier-3"),
+					resource.TestCheckResourceAttr(resourceName, "option_ids.tier-1", "opt-1"),
+					resource.TestCheckResourceAttr(resourceName, "component_types.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceCustomFieldDefinition_MutationErrors(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	state.failingMutations["createCustomFieldDefinition"] = "Custom field definition name is already taken"
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_custom_field_definition" "test" {
+  name            = "Tier"
+  type            = "TEXT"
+  component_types = ["SERVICE"]
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`failed to create custom field definition: GraphQL mutation returned success=false: Custom field definition name is already taken \(errorType: BAD_REQUEST, statusCode: 400\)`),
+			},
+		},
+	})
+}
+
+func TestResourceCustomFieldDefinition_InvalidOptions(t *testing.T) {
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := func(definition string) string {
+		return fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  cloud_id  = "cloud-123"
+}
+
+resource "compass_custom_field_definition" "test" {
+  name            = "Tier"
+  component_types = ["SERVICE"]
+%s
+}
+`, definition)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`  type = "SINGLE_SELECT"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("options are required for SINGLE_SELECT custom fields"),
+			},
+			{
+				Config: config(`
+  type    = "TEXT"
+  options = ["tier-1"]
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("options can only be set for SINGLE_SELECT and MULTI_SELECT custom fields, got type TEXT"),
+			},
+		},
+	})
+}
+
+func TestResourceCustomFieldDefinition_DeletedOutsideTerraform(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_custom_field_definition" "test" {
+  name            = "Tier"
+  type            = "TEXT"
+  component_types = ["SERVICE"]
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				PreConfig: func() {
+					state.mu.Lock()
+					for id := range state.fieldDefs {
+						delete(state.fieldDefs, id)
+					}
+					state.mu.Unlock()
+				},
+				Config: config,
+				Check: func(*terraform.State) error {
+					state.mu.Lock()
+					defer state.mu.Unlock()
+					if len(state.fieldDefs) != 1 {
+						return fmt.Errorf("expected the custom field definition to be recreated, got %d definitions", len(state.fieldDefs))
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
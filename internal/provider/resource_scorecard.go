@@ -0,0 +1,619 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scorecardCriterionTypes maps the criteria type values to the member of the criteria
+// inputs used for them and the CompassScorecardCriteria member returned on read.
+// HAS_CUSTOM_FIELD_VALUE criteria are mapped by their custom field type instead.
+var scorecardCriterionTypes = map[string]struct {
+	inputField string
+	typeName   string
+}{
+	"HAS_DESCRIPTION":  {inputField: "hasDescription", typeName: "CompassHasDescriptionScorecardCriteria"},
+	"HAS_OWNER":        {inputField: "hasOwner", typeName: "CompassHasOwnerScorecardCriteria"},
+	"HAS_LINK":         {inputField: "hasLink", typeName: "CompassHasLinkScorecardCriteria"},
+	"HAS_METRIC_VALUE": {inputField: "hasMetricValue", typeName: "CompassHasMetricValueScorecardCriteria"},
+}
+
+// scorecardCustomFieldCriterionTypes maps the custom_field_type values of HAS_CUSTOM_FIELD_VALUE
+// criteria to the member of the criteria inputs and the CompassScorecardCriteria member.
+var scorecardCustomFieldCriterionTypes = map[string]struct {
+	inputField string
+	typeName   string
+}{
+	"BOOLEAN": {inputField: "hasCustomBooleanValue", typeName: "CompassHasCustomBooleanFieldScorecardCriteria"},
+	"TEXT":    {inputField: "hasCustomTextValue", typeName: "CompassHasCustomTextFieldScorecardCriteria"},
+	"NUMBER":  {inputField: "hasCustomNumberValue", typeName: "CompassHasCustomNumberFieldScorecardCriteria"},
+}
+
+func resourceScorecard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScorecardCreate,
+		ReadContext:   resourceScorecardRead,
+		UpdateContext: resourceScorecardUpdate,
+		DeleteContext: resourceScorecardDelete,
+		Schema: map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Cloud ID of the Atlassian site. If not provided, the cloud_id of the provider is used, or it is automatically detected from tenant configured in provider.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the scorecard",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the scorecard",
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Atlassian account ID of the owner of the scorecard",
+			},
+			"importance": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateScorecardImportance,
+				Description:      "Importance of the scorecard. Valid values: REQUIRED, RECOMMENDED, USER_DEFINED",
+			},
+			"component_type_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the component types the scorecard applies to. Built-in types are addressed by their type value (e.g. SERVICE), custom types by the id of compass_component_type",
+			},
+			"criteria": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Criteria components are scored against. The weights of all criteria must add up to 100",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the criterion",
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateScorecardCriterionType,
+							Description:      "Type of the criterion. Valid values: HAS_DESCRIPTION, HAS_OWNER, HAS_LINK, HAS_METRIC_VALUE, HAS_CUSTOM_FIELD_VALUE",
+						},
+						"weight": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validateScorecardCriterionWeight,
+							Description:      "Weight of the criterion in the score, between 1 and 100",
+						},
+						"link_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateLinkType,
+							Description:      "Type of link the component must have. Required for HAS_LINK criteria. Valid values: DOCUMENT, CHAT_CHANNEL, REPOSITORY, PROJECT, DASHBOARD, ON_CALL, OTHER_LINK",
+						},
+						"metric_definition_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the metric definition whose value is checked. Required for HAS_METRIC_VALUE criteria",
+						},
+						"comparator": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateNumberComparator,
+							Description:      "How the value is compared to threshold. Required for HAS_METRIC_VALUE criteria and NUMBER custom fields. Valid values: EQUAL_TO, NOT_EQUAL_TO, GREATER_THAN, GREATER_THAN_OR_EQUAL_TO, LESS_THAN, LESS_THAN_OR_EQUAL_TO",
+						},
+						"threshold": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "Value the metric or custom field value is compared to. Required for HAS_METRIC_VALUE criteria and NUMBER custom fields",
+						},
+						"custom_field_definition_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the custom field definition whose value is checked. Required for HAS_CUSTOM_FIELD_VALUE criteria",
+						},
+						"custom_field_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateScorecardCustomFieldType,
+							Description:      "Type of the custom field. Required for HAS_CUSTOM_FIELD_VALUE criteria. Valid values: BOOLEAN, TEXT, NUMBER",
+						},
+						"boolean_value": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Value a BOOLEAN custom field must have. Defaults to true",
+						},
+					},
+				},
+			},
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateScorecardRawConfig},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceScorecardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	criteria := make([]map[string]interface{}, 0)
+	for _, raw := range d.Get("criteria").([]interface{}) {
+		criterion, err := expandScorecardCriterion(raw.(map[string]interface{}), "")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		criteria = append(criteria, criterion)
+	}
+
+	input := client.CreateCompassScorecardInput{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		OwnerId:          d.Get("owner_id").(string),
+		Importance:       client.CompassScorecardImportance(d.Get("importance").(string)),
+		ComponentTypeIds: expandStringSet(d.Get("component_type_ids").(*schema.Set)),
+		Criterias:        criteria,
+	}
+
+	response, err := client.CreateScorecard(ctx, compassClient, cloudID, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create scorecard: %w", err))
+	}
+
+	if !response.Compass.CreateScorecard.Success {
+		return diag.FromErr(mutationError("create scorecard", response.Compass.CreateScorecard.Errors))
+	}
+
+	d.SetId(response.Compass.CreateScorecard.ScorecardDetails.Id)
+
+	return resourceScorecardRead(ctx, d, m)
+}
+
+func resourceScorecardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	// cloud_id is not set yet when importing
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	response, err := client.GetScorecard(ctx, compassClient, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return removeNotFoundFromState(d, "scorecard")
+		}
+		return diag.FromErr(fmt.Errorf("failed to read scorecard: %w", err))
+	}
+
+	var scorecard *client.GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard
+	switch result := response.Compass.Scorecard.(type) {
+	case *client.GetScorecardCompassCompassCatalogQueryApiScorecardCompassScorecard:
+		scorecard = result
+	case *client.GetScorecardCompassCompassCatalogQueryApiScorecardQueryError:
+		if (QueryError{Message: result.Message, Extensions: result.Extensions}).IsNotFound() {
+			return removeNotFoundFromState(d, "scorecard")
+		}
+		return diag.Errorf("failed to read scorecard: %s", result.Message)
+	case nil:
+		return removeNotFoundFromState(d, "scorecard")
+	default:
+		return diag.Errorf("unexpected scorecard result %T", result)
+	}
+
+	ownerID := ""
+	if scorecard.Owner != nil {
+		ownerID = scorecard.Owner.GetAccountId()
+	}
+
+	d.Set("cloud_id", cloudID)
+	d.Set("name", scorecard.Name)
+	d.Set("description", scorecard.Description)
+	d.Set("owner_id", ownerID)
+	d.Set("importance", string(scorecard.Importance))
+	d.Set("component_type_ids", scorecard.ComponentTypeIds)
+	if err := d.Set("criteria", flattenScorecardCriteria(scorecard.Criterias, d.Get("criteria").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set criteria: %w", err))
+	}
+
+	return nil
+}
+
+func resourceScorecardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	// Check if any updatable fields have changed
+	if !d.HasChanges("name", "description", "owner_id", "importance", "component_type_ids", "criteria") {
+		// No changes to updatable fields, just read the state
+		return resourceScorecardRead(ctx, d, m)
+	}
+
+	cloudID, diags := providerConfig.ResourceCloudID(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Build update input
+	var input client.UpdateCompassScorecardInput
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		input.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		// Include description even if empty to allow clearing it
+		input.Description = &description
+	}
+
+	// ownerId is always sent, an empty owner_id is sent as null to clear the owner
+	if ownerID := d.Get("owner_id").(string); ownerID != "" {
+		input.OwnerId = &ownerID
+	}
+
+	if d.HasChange("importance") {
+		input.Importance = client.CompassScorecardImportance(d.Get("importance").(string))
+	}
+
+	if d.HasChange("component_type_ids") {
+		input.ComponentTypeIds = expandStringSet(d.Get("component_type_ids").(*schema.Set))
+	}
+
+	if d.HasChange("criteria") {
+		oldCriteria, newCriteria := d.GetChange("criteria")
+		createCriteria, updateCriteria, deleteCriteria, err := diffScorecardCriteria(oldCriteria.([]interface{}), newCriteria.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		input.CreateCriteria = createCriteria
+		input.UpdateCriteria = updateCriteria
+		input.DeleteCriteria = deleteCriteria
+	}
+
+	response, err := client.UpdateScorecard(ctx, compassClient, cloudID, d.Id(), input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update scorecard: %w", err))
+	}
+
+	if !response.Compass.UpdateScorecard.Success {
+		return diag.FromErr(mutationError("update scorecard", response.Compass.UpdateScorecard.Errors))
+	}
+
+	// Update successful, read the latest state
+	return resourceScorecardRead(ctx, d, m)
+}
+
+func resourceScorecardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConfig := m.(*ProviderConfig)
+	compassClient := providerConfig.Client
+
+	response, err := client.DeleteScorecard(ctx, compassClient, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete scorecard: %w", err))
+	}
+
+	if !response.Compass.DeleteScorecard.Success {
+		return diag.FromErr(mutationError("delete scorecard", response.Compass.DeleteScorecard.Errors))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// scorecardCriterionInputField returns the member of the criteria inputs used for a criteria block.
+func scorecardCriterionInputField(criterion map[string]interface{}) (string, bool) {
+	criterionType, _ := criterion["type"].(string)
+	if criterionType == "HAS_CUSTOM_FIELD_VALUE" {
+		customFieldType, _ := criterion["custom_field_type"].(string)
+		mapping, ok := scorecardCustomFieldCriterionTypes[customFieldType]
+		return mapping.inputField, ok
+	}
+	mapping, ok := scorecardCriterionTypes[criterionType]
+	return mapping.inputField, ok
+}
+
+// expandScorecardCriterion builds the CreateCompassScorecardCriteriaInput of a criteria block,
+// or the UpdateCompassScorecardCriteriaInput when the ID of an existing criterion is given.
+func expandScorecardCriterion(criterion map[string]interface{}, id string) (map[string]interface{}, error) {
+	criterionType := criterion["type"].(string)
+	inputField, ok := scorecardCriterionInputField(criterion)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s criterion: custom_field_type must be one of BOOLEAN, TEXT, NUMBER", criterionType)
+	}
+
+	value := map[string]interface{}{
+		"weight": criterion["weight"].(int),
+	}
+	if id != "" {
+		value["id"] = id
+	}
+
+	switch inputField {
+	case "hasLink":
+		value["linkType"] = criterion["link_type"].(string)
+	case "hasMetricValue":
+		value["metricDefinitionId"] = criterion["metric_definition_id"].(string)
+		value["comparator"] = criterion["comparator"].(string)
+		value["comparatorValue"] = criterion["threshold"].(float64)
+	case "hasCustomBooleanValue":
+		value["customFieldDefinitionId"] = criterion["custom_field_definition_id"].(string)
+		value["booleanComparator"] = "EQUAL_TO"
+		value["booleanComparatorValue"] = criterion["boolean_value"].(bool)
+	case "hasCustomNumberValue":
+		value["customFieldDefinitionId"] = criterion["custom_field_definition_id"].(string)
+		value["numberComparator"] = criterion["comparator"].(string)
+		value["numberComparatorValue"] = criterion["threshold"].(float64)
+	case "hasCustomTextValue":
+		value["customFieldDefinitionId"] = criterion["custom_field_definition_id"].(string)
+	}
+
+	return map[string]interface{}{inputField: value}, nil
+}
+
+// diffScorecardCriteria matches the criteria blocks with the existing criteria. The id of a block
+// is carried over from state by position, so blocks are first matched by their fields: a block
+// equal to an existing criterion keeps it, and reordering blocks changes nothing. A changed block
+// then updates the criterion of its ID, or another existing criterion of the same kind, so IDs
+// and score history are kept. Other blocks create new criteria and the rest are deleted.
+func diffScorecardCriteria(oldCriteria, newCriteria []interface{}) (createCriteria, updateCriteria []map[string]interface{}, deleteCriteria []client.DeleteCompassScorecardCriteriaInput, err error) {
+	used := make([]bool, len(oldCriteria))
+	matched := make([]int, len(newCriteria))
+	for i, raw := range newCriteria {
+		matched[i] = -1
+		for j, old := range oldCriteria {
+			id, _ := old.(map[string]interface{})["id"].(string)
+			if !used[j] && id != "" && equalScorecardCriteria(old.(map[string]interface{}), raw.(map[string]interface{})) {
+				matched[i], used[j] = j, true
+				break
+			}
+		}
+	}
+
+	// matchOld returns an unused existing criterion of the same kind as criterion, the one
+	// with the given ID if there is one.
+	matchOld := func(criterion map[string]interface{}, id string) int {
+		newField, _ := scorecardCriterionInputField(criterion)
+		match := -1
+		for j, raw := range oldCriteria {
+			old := raw.(map[string]interface{})
+			oldID, _ := old["id"].(string)
+			oldField, _ := scorecardCriterionInputField(old)
+			if used[j] || oldID == "" || oldField != newField {
+				continue
+			}
+			if oldID == id {
+				return j
+			}
+			if match == -1 {
+				match = j
+			}
+		}
+		return match
+	}
+
+	for i, raw := range newCriteria {
+		if matched[i] != -1 {
+			continue
+		}
+		criterion := raw.(map[string]interface{})
+		id, _ := criterion["id"].(string)
+
+		if j := matchOld(criterion, id); j != -1 {
+			used[j] = true
+			value, err := expandScorecardCriterion(criterion, oldCriteria[j].(map[string]interface{})["id"].(string))
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			updateCriteria = append(updateCriteria, value)
+			continue
+		}
+
+		value, err := expandScorecardCriterion(criterion, "")
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		createCriteria = append(createCriteria, value)
+	}
+
+	for j, raw := range oldCriteria {
+		id, _ := raw.(map[string]interface{})["id"].(string)
+		if id != "" && !used[j] {
+			deleteCriteria = append(deleteCriteria, client.DeleteCompassScorecardCriteriaInput{Id: id})
+		}
+	}
+
+	return createCriteria, updateCriteria, deleteCriteria, nil
+}
+
+// equalScorecardCriteria reports whether two criteria blocks are equal apart from their IDs.
+func equalScorecardCriteria(a, b map[string]interface{}) bool {
+	for key, value := range a {
+		if key != "id" && b[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenScorecardCriteria converts the criteria of a scorecard into criteria blocks. They are
+// ordered like the blocks in prior, matched by their fields or, for criteria changed outside of
+// Terraform, by ID. The fields come first as the IDs of planned blocks are carried over by
+// position. Criteria not in prior (e.g. added in the UI) are appended in the order of the API.
+func flattenScorecardCriteria(criteria []client.ScorecardCriterion, prior []interface{}) []interface{} {
+	flattened := make([]map[string]interface{}, 0, len(criteria))
+	for _, c := range criteria {
+		criterion := map[string]interface{}{
+			"id":                         c.ID,
+			"type":                       "",
+			"weight":                     c.Weight,
+			"link_type":                  c.LinkType,
+			"metric_definition_id":       c.MetricDefinitionID,
+			"comparator":                 "",
+			"threshold":                  0.0,
+			"custom_field_definition_id": "",
+			"custom_field_type":          "",
+			"boolean_value":              true,
+		}
+
+		for t, mapping := range scorecardCriterionTypes {
+			if mapping.typeName == c.TypeName {
+				criterion["type"] = t
+			}
+		}
+		for t, mapping := range scorecardCustomFieldCriterionTypes {
+			if mapping.typeName == c.TypeName {
+				criterion["type"] = "HAS_CUSTOM_FIELD_VALUE"
+				criterion["custom_field_type"] = t
+			}
+		}
+
+		if c.CustomFieldDefinition != nil {
+			criterion["custom_field_definition_id"] = c.CustomFieldDefinition.ID
+		}
+		if c.BooleanComparatorValue != nil {
+			criterion["boolean_value"] = *c.BooleanComparatorValue
+		}
+		// Metric and number custom field criteria share comparator and threshold
+		if c.Comparator != "" {
+			criterion["comparator"] = c.Comparator
+		} else if c.NumberComparator != "" {
+			criterion["comparator"] = c.NumberComparator
+		}
+		if c.ComparatorValue != nil {
+			criterion["threshold"] = *c.ComparatorValue
+		} else if c.NumberComparatorValue != nil {
+			criterion["threshold"] = *c.NumberComparatorValue
+		}
+
+		flattened = append(flattened, criterion)
+	}
+
+	used := make([]bool, len(flattened))
+	order := make([]int, len(prior))
+	for i, raw := range prior {
+		order[i] = -1
+		for j, criterion := range flattened {
+			if !used[j] && equalScorecardCriteria(raw.(map[string]interface{}), criterion) {
+				order[i], used[j] = j, true
+				break
+			}
+		}
+	}
+	for i, raw := range prior {
+		if order[i] != -1 {
+			continue
+		}
+		id, _ := raw.(map[string]interface{})["id"].(string)
+		for j, criterion := range flattened {
+			if !used[j] && id != "" && criterion["id"] == id {
+				order[i], used[j] = j, true
+				break
+			}
+		}
+	}
+
+	result := make([]interface{}, 0, len(flattened))
+	for _, j := range order {
+		if j != -1 {
+			result = append(result, flattened[j])
+		}
+	}
+	for j, criterion := range flattened {
+		if !used[j] {
+			result = append(result, criterion)
+		}
+	}
+	return result
+}
+
+// scorecardCriterionRequiredArgs returns the arguments a criteria block of the given type needs.
+func scorecardCriterionRequiredArgs(criterionType string, customFieldType cty.Value) []string {
+	switch criterionType {
+	case "HAS_LINK":
+		return []string{"link_type"}
+	case "HAS_METRIC_VALUE":
+		return []string{"metric_definition_id", "comparator", "threshold"}
+	case "HAS_CUSTOM_FIELD_VALUE":
+		args := []string{"custom_field_definition_id", "custom_field_type"}
+		if !customFieldType.IsNull() && customFieldType.IsKnown() && customFieldType.AsString() == "NUMBER" {
+			args = append(args, "comparator", "threshold")
+		}
+		return args
+	}
+	return nil
+}
+
+// validateScorecardRawConfig checks that every criteria block sets the arguments its type needs
+// and that the weights of the criteria add up to 100. Values that are not known yet are skipped.
+func validateScorecardRawConfig(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+		return
+	}
+	criteria := req.RawConfig.GetAttr("criteria")
+	if criteria.IsNull() || !criteria.IsKnown() {
+		return
+	}
+
+	totalWeight, weightsKnown := int64(0), true
+	for it := criteria.ElementIterator(); it.Next(); {
+		key, criterion := it.Element()
+		if criterion.IsNull() || !criterion.IsKnown() {
+			weightsKnown = false
+			continue
+		}
+		path := cty.GetAttrPath("criteria").Index(key)
+
+		if criterionType := criterion.GetAttr("type"); !criterionType.IsNull() && criterionType.IsKnown() {
+			for _, arg := range scorecardCriterionRequiredArgs(criterionType.AsString(), criterion.GetAttr("custom_field_type")) {
+				if criterion.GetAttr(arg).IsNull() {
+					resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+						Severity:      diag.Error,
+						Summary:       "Missing criterion argument",
+						Detail:        fmt.Sprintf("%s is required for %s criteria", arg, criterionType.AsString()),
+						AttributePath: path.GetAttr(arg),
+					})
+				}
+			}
+		}
+
+		weight := criterion.GetAttr("weight")
+		if weight.IsNull() || !weight.IsKnown() {
+			weightsKnown = false
+			continue
+		}
+		w, _ := weight.AsBigFloat().Int64()
+		totalWeight += w
+	}
+
+	if weightsKnown && totalWeight != 100 {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid criteria weights",
+			Detail:        fmt.Sprintf("the weights of the criteria of a scorecard must add up to 100, got %d", totalWeight),
+			AttributePath: cty.GetAttrPath("criteria"),
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/OSapozhnikov/terraform-provider-atlassian-compass/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceScorecard_CRUD(t *testing.T) {
+	state := newMockState()
+	server := startMockGraphQLServer(state)
+	defer server.Close()
+
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	resourceName := "compass_scorecard.test"
+	initial := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_scorecard" "test" {
+  name               = "Service readiness"
+  description        = "Basics every service needs"
+  owner_id           = "account-1"
+  importance         = "REQUIRED"
+  component_type_ids = ["SERVICE"]
+
+  criteria {
+    type   = "HAS_DESCRIPTION"
+    weight = 20
+  }
+
+  criteria {
+    type   = "HAS_OWNER"
+    weight = 20
+  }
+
+  criteria {
+    type      = "HAS_LINK"
+    weight    = 30
+    link_type = "REPOSITORY"
+  }
+
+  criteria {
+    type                 = "HAS_METRIC_VALUE"
+    weight               = 30
+    metric_definition_id = "metric-1"
+    comparator           = "GREATER_THAN_OR_EQUAL_TO"
+    threshold            = 80
+  }
+}
+`, server.URL)
+
+	updated := fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  base_url  = "%s"
+  tenant    = "temabit"
+}
+
+resource "compass_scorecard" "test" {
+  name               = "Service readiness"
+  importance         = "RECOMMENDED"
+  component_type_ids = ["SERVICE", "APPLICATION"]
+
+  criteria {
+    type   = "HAS_DESCRIPTION"
+    weight = 10
+  }
+
+  criteria {
+    type   = "HAS_OWNER"
+    weight = 20
+  }
+
+  criteria {
+    type                       = "HAS_CUSTOM_FIELD_VALUE"
+    weight                     = 40
+    custom_field_definition_id = "cfd-1"
+    custom_field_type          = "NUMBER"
+    comparator                 = "LESS_THAN"
+    threshold                  = 3
+  }
+
+  criteria {
+    type                 = "HAS_METRIC_VALUE"
+    weight               = 30
+    metric_definition_id = "metric-1"
+    comparator           = "GREATER_THAN_OR_EQUAL_TO"
+    threshold            = 80
+  }
+}
+`, server.URL)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: initial,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "scorecard-1"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Service readiness"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Basics every service needs"),
+					resource.TestCheckResourceAttr(resourceName, "owner_id", "account-1"),
+					resource.TestCheckResourceAttr(resourceName, "importance", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "component_type_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.id", "crit-1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.2.link_type", "REPOSITORY"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.3.comparator", "GREATER_THAN_OR_EQUAL_TO"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.3.threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "cloud_id", state.cloudID),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "owner_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "importance", "RECOMMENDED"),
+					resource.TestCheckResourceAttr(resourceName, "component_type_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "4"),
+					// Criteria of the same kind are updated in place and keep their IDs
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.id", "crit-1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.weight", "10"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.1.id", "crit-2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.3.id", "crit-4"),
+					// The link criterion is replaced by a new custom field criterion
+					resource.TestCheckResourceAttr(resourceName, "criteria.2.id", "crit-5"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.2.type", "HAS_CUSTOM_FIELD_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.2.custom_field_type", "NUMBER"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.2.custom_field_definition_id", "cfd-1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.2.comparator", "LESS_THAN"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.2.threshold", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceScorecard_InvalidCriteria(t *testing.T) {
+	prov := New()
+	providerFactories := map[string]func() (*schema.Provider, error){
+		"compass": func() (*schema.Provider, error) { return prov, nil },
+	}
+
+	config := func(criteria string) string {
+		return fmt.Sprintf(`
+provider "compass" {
+  email     = "test@example.com"
+  api_token = "test-token"
+  cloud_id  = "cloud-123"
+}
+
+resource "compass_scorecard" "test" {
+  name               = "Service readiness"
+  importance         = "REQUIRED"
+  component_type_ids = ["SERVICE"]
+%s
+}
+`, criteria)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  criteria {
+    type   = "HAS_DESCRIPTION"
+    weight = 50
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the weights of the criteria of a scorecard must add up to 100, got 50"),
+			},
+			{
+				Config: config(`
+  criteria {
+    type   = "HAS_LINK"
+    weight = 100
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("link_type is required for HAS_LINK criteria"),
+			},
+		},
+	})
+}
+
+func TestDiffScorecardCriteria(t *testing.T) {
+	criterion := func(id, criterionType string, weight int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                         id,
+			"type":                       criterionType,
+			"weight":                     weight,
+			"link_type":                  "",
+			"metric_definition_id":       "",
+			"comparator":                 "",
+			"threshold":                  0.0,
+			"custom_field_definition_id": "",
+			"custom_field_type":          "",
+			"boolean_value":              true,
+		}
+	}
+	hasLink := func(id string, weight int) map[string]interface{} {
+		c := criterion(id, "HAS_LINK", weight)
+		c["link_type"] = "REPOSITORY"
+		return c
+	}
+
+	oldCriteria := []interface{}{
+		criterion("crit-1", "HAS_DESCRIPTION", 50),
+		criterion("crit-2", "HAS_OWNER", 50),
+	}
+
+	// The ids of the new blocks are carried over from state by position, like in a plan
+	tests := []struct {
+		name        string
+		newCriteria []interface{}
+		created     []string
+		updated     []string
+		deleted     []string
+	}{
+		{
+			name: "reordered",
+			newCriteria: []interface{}{
+				criterion("crit-1", "HAS_OWNER", 50),
+				criterion("crit-2", "HAS_DESCRIPTION", 50),
+			},
+		},
+		{
+			name: "reordered and changed",
+			newCriteria: []interface{}{
+				criterion("crit-1", "HAS_OWNER", 60),
+				criterion("crit-2", "HAS_DESCRIPTION", 40),
+			},
+			updated: []string{"hasOwner crit-2", "hasDescription crit-1"},
+		},
+		{
+			name: "inserted",
+			newCriteria: []interface{}{
+				criterion("crit-1", "HAS_DESCRIPTION", 50),
+				hasLink("crit-2", 30),
+				criterion("", "HAS_OWNER", 20),
+			},
+			created: []string{"hasLink"},
+			updated: []string{"hasOwner crit-2"},
+		},
+		{
+			name: "replaced",
+			newCriteria: []interface{}{
+				criterion("crit-1", "HAS_DESCRIPTION", 50),
+				hasLink("crit-2", 50),
+			},
+			created: []string{"hasLink"},
+			deleted: []string{"crit-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createCriteria, updateCriteria, deleteCriteria, err := diffScorecardCriteria(oldCriteria, tt.newCriteria)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var created, updated, deleted []string
+			for _, c := range createCriteria {
+				for member := range c {
+					created = append(created, member)
+				}
+			}
+			for _, c := range updateCriteria {
+				for member, value := range c {
+					updated = append(updated, member+" "+value.(map[string]interface{})["id"].(string))
+				}
+			}
+			for _, c := range deleteCriteria {
+				deleted = append(deleted, c.Id)
+			}
+
+			if !reflect.DeepEqual(created, tt.created) {
+				t.Errorf("expected created criteria %v, got %v", tt.created, created)
+			}
+			if !reflect.DeepEqual(updated, tt.updated) {
+				t.Errorf("expected updated criteria %v, got %v", tt.updated, updated)
+			}
+			if !reflect.DeepEqual(deleted, tt.deleted) {
+				t.Errorf("expected deleted criteria %v, got %v", tt.deleted, deleted)
+			}
+		})
+	}
+}
+
+func TestFlattenScorecardCriteria_KeepsOrderOfPrior(t *testing.T) {
+	criteria := []client.ScorecardCriterion{
+		{TypeName: "CompassHasDescriptionScorecardCriteria", ID: "crit-1", Weight: 50},
+		{TypeName: "CompassHasOwnerScorecardCriteria", ID: "crit-2", Weight: 50},
+		{TypeName: "CompassHasLinkScorecardCriteria", ID: "crit-3", Weight: 10, LinkType: "REPOSITORY"},
+	}
+
+	// Reordered blocks still carry the ids of the blocks that were at their position
+	prior := flattenScorecardCriteria(criteria[:2], nil)
+	prior[0], prior[1] = prior[1], prior[0]
+	prior[0].(map[string]interface{})["id"], prior[1].(map[string]interface{})["id"] = "crit-1", "crit-2"
+
+	var ids []string
+	for _, c := range flattenScorecardCriteria(criteria, prior) {
+		ids = append(ids, c.(map[string]interface{})["id"].(string))
+	}
+	if expected := []string{"crit-2", "crit-1", "crit-3"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected criteria %v, got %v", expected, ids)
+	}
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Link types with extra checks of their URL
+const (
+	linkTypeChatChannel = "CHAT_CHANNEL"
+	linkTypeRepository  = "REPOSITORY"
+)
+
+var (
+	// componentTypeValues are the values of the CompassComponentType enum
+	componentTypeValues = []string{"SERVICE", "LIBRARY", "APPLICATION", "INFRASTRUCTURE", "DATABASE", "DOCUMENTATION"}
+
+	// linkTypeValues are the values of the CompassLinkType enum
+	linkTypeValues = []string{"DOCUMENT", linkTypeChatChannel, linkTypeRepository, "PROJECT", "DASHBOARD", "ON_CALL", "OTHER_LINK"}
+
+	// customFieldTypeValues are the types of custom fields, see customFieldTypes
+	customFieldTypeValues = []string{"BOOLEAN", "TEXT", "NUMBER", "SINGLE_SELECT", "MULTI_SELECT", "USER"}
+
+	// relationshipTypeValues are the values of the CompassRelationshipType enum supported by the provider
+	relationshipTypeValues = []string{"DEPENDS_ON"}
+
+	// scorecardImportanceValues are the values of the CompassScorecardImportance enum
+	scorecardImportanceValues = []string{"REQUIRED", "RECOMMENDED", "USER_DEFINED"}
+
+	// scorecardCriterionTypeValues are the criteria types of compass_scorecard, see scorecardCriterionInputField
+	scorecardCriterionTypeValues = []string{"HAS_DESCRIPTION", "HAS_OWNER", "HAS_LINK", "HAS_METRIC_VALUE", "HAS_CUSTOM_FIELD_VALUE"}
+
+	// scorecardCustomFieldTypeValues are the custom field types scorecard criteria can check
+	scorecardCustomFieldTypeValues = []string{"BOOLEAN", "TEXT", "NUMBER"}
+
+	// numberComparatorValues are the values of the CompassCriteriaNumberComparatorOptions enum
+	numberComparatorValues = []string{"EQUAL_TO", "NOT_EQUAL_TO", "GREATER_THAN", "GREATER_THAN_OR_EQUAL_TO", "LESS_THAN", "LESS_THAN_OR_EQUAL_TO"}
+
+	// linkURLSchemes are the URL schemes Compass accepts for links
+	linkURLSchemes = []string{"http", "https", "ftp", "git", "ssh"}
+
+	// chatHosts are the domains of CHAT_CHANNEL link URLs
+	chatHosts = []string{"slack.com", "teams.microsoft.com", "teams.live.com"}
+
+	// gitHosts are the domains of hosted git services accepted for REPOSITORY link URLs
+	gitHosts = []string{"github.com", "gitlab.com", "bitbucket.org", "dev.azure.com", "visualstudio.com", "codeberg.org", "sr.ht"}
+)
+
+var (
+	validateComponentType    = validation.ToDiagFunc(validation.StringInSlice(componentTypeValues, false))
+	validateLinkType         = validation.ToDiagFunc(validation.StringInSlice(linkTypeValues, false))
+	validateCustomFieldType  = validation.ToDiagFunc(validation.StringInSlice(customFieldTypeValues, false))
+	validateRelationshipType = validation.ToDiagFunc(validation.StringInSlice(relationshipTypeValues, false))
+	validateLinkURL          = validation.ToDiagFunc(validation.IsURLWithScheme(linkURLSchemes))
+
+	validateScorecardImportance      = validation.ToDiagFunc(validation.StringInSlice(scorecardImportanceValues, false))
+	validateScorecardCriterionType   = validation.ToDiagFunc(validation.StringInSlice(scorecardCriterionTypeValues, false))
+	validateScorecardCustomFieldType = validation.ToDiagFunc(validation.StringInSlice(scorecardCustomFieldTypeValues, false))
+	validateNumberComparator         = validation.ToDiagFunc(validation.StringInSlice(numberComparatorValues, false))
+	validateScorecardCriterionWeight = validation.ToDiagFunc(validation.IntBetween(1, 100))
+)
+
+// invalidCustomFieldTypeError reports a custom field type without a mapping. The schema rejects
+// such types at plan time already, so this only guards the lookups during apply.
+func invalidCustomFieldTypeError(fieldType string) error {
+	return fmt.Errorf("invalid custom field type: %s. Valid values are: %s", fieldType, strings.Join(customFieldTypeValues, ", "))
+}
+
+func missingCustomFieldValueError(definitionID, fieldType string) error {
+	return fmt.Errorf("%s is required for the %s custom field %s", customFieldTypes[fieldType].attribute, fieldType, definitionID)
+}
+
+// validateLinkURLForType checks that the URL of a link fits its type: CHAT_CHANNEL links must
+// point to Slack or Microsoft Teams and REPOSITORY links to a git host. URLs that can't be
+// parsed are left to validateLinkURL.
+func validateLinkURLForType(linkType, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+	host := strings.ToLower(u.Hostname())
+
+	switch linkType {
+	case linkTypeChatChannel:
+		if !hostInDomains(host, chatHosts) {
+			return fmt.Errorf("url of a %s link must be a Slack or Microsoft Teams URL, got %s", linkType, rawURL)
+		}
+	case linkTypeRepository:
+		if !isGitURL(u) {
+			return fmt.Errorf("url of a %s link must point to a git host such as GitHub, GitLab or Bitbucket, got %s", linkType, rawURL)
+		}
+	}
+	return nil
+}
+
+// isGitURL reports whether u points to a git repository: a git or ssh URL, a hosted git
+// service, a self-hosted one named like git.example.com or gitlab.example.com, or a path
+// ending in .git.
+func isGitURL(u *url.URL) bool {
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case u.Scheme == "git" || u.Scheme == "ssh":
+		return true
+	case hostInDomains(host, gitHosts):
+		return true
+	case strings.HasPrefix(host, "git") || strings.HasPrefix(host, "bitbucket."):
+		return true
+	default:
+		return strings.HasSuffix(u.Path, ".git")
+	}
+}
+
+// hostInDomains reports whether host is one of domains or a subdomain of one of them.
+func hostInDomains(host string, domains []string) bool {
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateLinkConfig runs validateLinkURLForType on the type and url attributes of link.
+// Values that are not known yet, e.g. references to other resources, are skipped.
+func validateLinkConfig(link cty.Value, path cty.Path) diag.Diagnostics {
+	if link.IsNull() || !link.IsKnown() {
+		return nil
+	}
+	linkType, linkURL := link.GetAttr("type"), link.GetAttr("url")
+	if linkType.IsNull() || !linkType.IsKnown() || linkURL.IsNull() || !linkURL.IsKnown() {
+		return nil
+	}
+
+	if err := validateLinkURLForType(linkType.AsString(), linkURL.AsString()); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "Invalid link URL",
+				Detail:        err.Error(),
+				AttributePath: path.GetAttr("url"),
+			},
+		}
+	}
+	return nil
+}
+
+// validateComponentLinkRawConfig checks the url of a compass_component_link against its type.
+// It needs both attributes, so it runs on the whole configuration instead of a single attribute.
+func validateComponentLinkRawConfig(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	resp.Diagnostics = append(resp.Diagnostics, validateLinkConfig(req.RawConfig, cty.Path{})...)
+}
+
+// validateLinkBlocksRawConfig checks the url of every link block against its type.
+func validateLinkBlocksRawConfig(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+		return
+	}
+	links := req.RawConfig.GetAttr("link")
+	if links.IsNull() || !links.IsKnown() {
+		return
+	}
+
+	for it := links.ElementIterator(); it.Next(); {
+		key, link := it.Element()
+		resp.Diagnostics = append(resp.Diagnostics, validateLinkConfig(link, cty.GetAttrPath("link").Index(key))...)
+	}
+}
+
+// validateCustomFieldBlocksRawConfig checks that every custom_field block sets exactly the value
+// attribute matching its type. Values of other types would never be read back from Compass and
+// show up as a permanent diff. Values that are not known yet are skipped.
+func validateCustomFieldBlocksRawConfig(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+		return
+	}
+	customFields := req.RawConfig.GetAttr("custom_field")
+	if customFields.IsNull() || !customFields.IsKnown() {
+		return
+	}
+
+	for it := customFields.ElementIterator(); it.Next(); {
+		key, customField := it.Element()
+		if customField.IsNull() || !customField.IsKnown() {
+			continue
+		}
+		fieldType := customField.GetAttr("type")
+		if fieldType.IsNull() || !fieldType.IsKnown() {
+			continue
+		}
+		mapping, ok := customFieldTypes[fieldType.AsString()]
+		if !ok {
+			// Reported by the validation of type
+			continue
+		}
+		path := cty.GetAttrPath("custom_field").Index(key)
+
+		for _, otherType := range customFieldTypeValues {
+			other := customFieldTypes[otherType]
+			value := customField.GetAttr(other.attribute)
+			set := !value.IsNull() && (!value.IsKnown() || !isEmptyConfigValue(value))
+			switch {
+			case other.attribute == mapping.attribute && !set:
+				resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Missing custom field value",
+					Detail:        fmt.Sprintf("%s is required for %s custom fields", mapping.attribute, fieldType.AsString()),
+					AttributePath: path.GetAttr(mapping.attribute),
+				})
+			case other.attribute != mapping.attribute && set:
+				resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Invalid custom field value",
+					Detail:        fmt.Sprintf("%s can't be set for %s custom fields, only %s", other.attribute, fieldType.AsString(), mapping.attribute),
+					AttributePath: path.GetAttr(other.attribute),
+				})
+			}
+		}
+	}
+}
+
+// isEmptyConfigValue reports whether a known string or collection value is empty. Such values
+// are not sent to Compass, so they count as not set.
+func isEmptyConfigValue(value cty.Value) bool {
+	switch {
+	case value.Type() == cty.String:
+		return value.AsString() == ""
+	case value.Type().IsSetType() || value.Type().IsListType():
+		return value.LengthInt() == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestValidateLinkURLForType(t *testing.T) {
+	cases := []struct {
+		linkType string
+		url      string
+		wantErr  bool
+	}{
+		{linkType: "CHAT_CHANNEL", url: "https://slack.com/channels/svc-a"},
+		{linkType: "CHAT_CHANNEL", url: "https://example.slack.com/archives/C0123456789"},
+		{linkType: "CHAT_CHANNEL", url: "https://teams.microsoft.com/l/channel/19%3a123"},
+		{linkType: "CHAT_CHANNEL", url: "https://example.com/chat", wantErr: true},
+		{linkType: "CHAT_CHANNEL", url: "https://notslack.com/channels/svc-a", wantErr: true},
+		{linkType: "REPOSITORY", url: "https://github.com/example/repo"},
+		{linkType: "REPOSITORY", url: "https://gitlab.example.com/team/repo"},
+		{linkType: "REPOSITORY", url: "https://example.com/team/repo.git"},
+		{linkType: "REPOSITORY", url: "ssh://git@example.com/team/repo"},
+		{linkType: "REPOSITORY", url: "https://example.com/repo", wantErr: true},
+		{linkType: "DOCUMENT", url: "https://example.com/docs"},
+		// Unparsable URLs are reported by validateLinkURL instead
+		{linkType: "REPOSITORY", url: "not a url"},
+	}
+
+	for _, tc := range cases {
+		err := validateLinkURLForType(tc.linkType, tc.url)
+		if tc.wantErr && err == nil {
+			t.Errorf("validateLinkURLForType(%q, %q): expected error, got none", tc.linkType, tc.url)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("validateLinkURLForType(%q, %q): unexpected error: %v", tc.linkType, tc.url, err)
+		}
+	}
+}